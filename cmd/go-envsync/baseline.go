@@ -0,0 +1,178 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+)
+
+// Constants for baseline snapshots
+const (
+	// BaselineFilePermissions defines the permissions of baseline snapshot files.
+	BaselineFilePermissions = 0o600
+
+	// BaselineVersion is the version of the baseline snapshot format. Version 2 replaced
+	// the unsalted SHA-256 fingerprints of version 1 with salted HMAC-SHA256.
+	BaselineVersion = 2
+
+	// unsaltedBaselineVersion is the version of baselines holding unsalted fingerprints.
+	// They are still compared, then upgraded to BaselineVersion.
+	unsaltedBaselineVersion = 1
+
+	// unsaltedFingerprintPrefix prefixes the SHA-256 fingerprints of version 1 baselines.
+	unsaltedFingerprintPrefix = "sha256:"
+)
+
+// baselineSnapshot is the on-disk form of a baseline. It holds value fingerprints and the
+// hex-encoded salt they were computed with, never the values themselves.
+type baselineSnapshot struct {
+	Version      int               `json:"version"`
+	Salt         string            `json:"salt"`
+	Fingerprints map[string]string `json:"fingerprints"`
+}
+
+// readBaseline reads a baseline snapshot as a fingerprinted environment, its version and
+// the salt of a version 2 snapshot. It returns a nil environment without error when the
+// file does not exist yet.
+func readBaseline(path string) (*client.Environment, int, []byte, error) {
+	// #nosec G304 - path is supplied by the user on the command line
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, 0, nil, nil
+	}
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("failed to read baseline %s: %w", path, err)
+	}
+
+	var snapshot baselineSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, 0, nil, fmt.Errorf("invalid baseline %s: %w", path, err)
+	}
+	if snapshot.Fingerprints == nil {
+		snapshot.Fingerprints = make(map[string]string)
+	}
+
+	switch snapshot.Version {
+	case unsaltedBaselineVersion:
+		return &client.Environment{Data: snapshot.Fingerprints}, snapshot.Version, nil, nil
+	case BaselineVersion:
+		salt, err := hex.DecodeString(snapshot.Salt)
+		if err != nil || len(salt) == 0 {
+			return nil, 0, nil, fmt.Errorf("invalid baseline %s: missing or malformed salt", path)
+		}
+		return &client.Environment{Data: snapshot.Fingerprints}, snapshot.Version, salt, nil
+	default:
+		return nil, 0, nil, fmt.Errorf("unsupported baseline version %d in %s, expected %d",
+			snapshot.Version, path, BaselineVersion)
+	}
+}
+
+// unsaltedFingerprinted returns a copy of the environment with every value replaced by its
+// version 1 fingerprint, for comparison with a version 1 baseline.
+func unsaltedFingerprinted(env *client.Environment) *client.Environment {
+	fingerprinted := &client.Environment{Data: make(map[string]string, len(env.Data))}
+	for key, value := range env.Data {
+		sum := sha256.Sum256([]byte(value))
+		fingerprinted.Data[key] = unsaltedFingerprintPrefix + hex.EncodeToString(sum[:])
+	}
+	return fingerprinted
+}
+
+// writeBaseline stores the fingerprints of a fingerprinted environment and their salt as
+// the new baseline.
+func writeBaseline(path string, fingerprinted *client.Environment, salt []byte) error {
+	data, err := json.MarshalIndent(baselineSnapshot{
+		Version:      BaselineVersion,
+		Salt:         hex.EncodeToString(salt),
+		Fingerprints: fingerprinted.Data,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode baseline: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), BaselineFilePermissions); err != nil {
+		return fmt.Errorf("failed to write baseline %s: %w", path, err)
+	}
+	return nil
+}
+
+// checkBaseline compares the environment with the --baseline snapshot, reports rotated keys
+// and stores the new snapshot. A missing snapshot is created with a new salt, which later
+// snapshots keep; dry runs leave it untouched. A version 1 snapshot is compared through its
+// unsalted fingerprints and then rewritten as version 2 with a new salt.
+func checkBaseline(output, status io.Writer, env *client.Environment) error {
+	baseline, version, salt, err := readBaseline(loadBaseline)
+	if err != nil {
+		return err
+	}
+	if salt == nil {
+		if salt, err = client.NewFingerprintSalt(); err != nil {
+			return err
+		}
+	}
+
+	current := env.Fingerprinted(salt)
+	switch {
+	case baseline == nil:
+		fmt.Fprintf(status, "No baseline found, recording %d keys in %s\n", len(current.Data), loadBaseline)
+	case version == unsaltedBaselineVersion:
+		printRotations(output, baseline.Diff(unsaltedFingerprinted(env)), loadBaselineAll)
+		if !loadDryRun {
+			fmt.Fprintf(status, "Upgrading baseline %s from unsalted version %d to version %d\n",
+				loadBaseline, unsaltedBaselineVersion, BaselineVersion)
+		}
+	default:
+		printRotations(output, baseline.Diff(current), loadBaselineAll)
+	}
+
+	if loadDryRun {
+		return nil
+	}
+	return writeBaseline(loadBaseline, current, salt)
+}
+
+// printRotations prints the keys whose values changed since the baseline and, when
+// includeAll is set, the keys added or removed since then.
+func printRotations(w io.Writer, diff client.Diff, includeAll bool) {
+	rotated := make([]string, 0, len(diff.Changed))
+	for key := range diff.Changed {
+		rotated = append(rotated, key)
+	}
+	sort.Strings(rotated)
+
+	if len(rotated) == 0 {
+		fmt.Fprintln(w, "No rotated keys since baseline")
+	} else {
+		fmt.Fprintf(w, "Rotated keys since baseline (%d):\n", len(rotated))
+		for _, key := range rotated {
+			fmt.Fprintf(w, "  ~ %s\n", key)
+		}
+	}
+
+	if !includeAll {
+		return
+	}
+	for _, key := range sortedDiffKeys(diff.Added) {
+		fmt.Fprintf(w, "  + %s\n", key)
+	}
+	for _, key := range sortedDiffKeys(diff.Removed) {
+		fmt.Fprintf(w, "  - %s\n", key)
+	}
+}
+
+// sortedDiffKeys returns the keys of an added or removed diff section in sorted order.
+func sortedDiffKeys(section map[string]string) []string {
+	keys := make([]string, 0, len(section))
+	for key := range section {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
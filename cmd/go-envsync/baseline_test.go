@@ -0,0 +1,90 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+)
+
+// writeBaselineFile writes a baseline snapshot file and returns its path.
+func writeBaselineFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, []byte(content), BaselineFilePermissions); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestReadBaselineRoundTrip(t *testing.T) {
+	salt, err := client.NewFingerprintSalt()
+	if err != nil {
+		t.Fatalf("NewFingerprintSalt() error = %v", err)
+	}
+
+	env := &client.Environment{Data: map[string]string{"TOKEN": "secret"}}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := writeBaseline(path, env.Fingerprinted(salt), salt); err != nil {
+		t.Fatalf("writeBaseline() error = %v", err)
+	}
+
+	baseline, version, readSalt, err := readBaseline(path)
+	if err != nil {
+		t.Fatalf("readBaseline() error = %v", err)
+	}
+	if version != BaselineVersion || string(readSalt) != string(salt) {
+		t.Errorf("readBaseline() version = %d, salt match = %t", version, string(readSalt) == string(salt))
+	}
+
+	rotated := &client.Environment{Data: map[string]string{"TOKEN": "rotated"}}
+	if diff := baseline.Diff(rotated.Fingerprinted(readSalt)); len(diff.Changed) != 1 {
+		t.Errorf("Diff() against a rotated value changed %v, want TOKEN", diff.Changed)
+	}
+	if diff := baseline.Diff(env.Fingerprinted(readSalt)); len(diff.Changed) != 0 {
+		t.Errorf("Diff() against the same value changed %v, want none", diff.Changed)
+	}
+}
+
+func TestReadBaselineUnsaltedVersion(t *testing.T) {
+	// sha256("secret")
+	path := writeBaselineFile(t, `{"version": 1, "fingerprints": {
+		"TOKEN": "sha256:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"}}`)
+
+	baseline, version, salt, err := readBaseline(path)
+	if err != nil {
+		t.Fatalf("readBaseline() error = %v", err)
+	}
+	if baseline == nil || version != unsaltedBaselineVersion || salt != nil {
+		t.Fatalf("readBaseline() = %v, version %d, salt %x; want a version 1 baseline", baseline, version, salt)
+	}
+
+	unchanged := &client.Environment{Data: map[string]string{"TOKEN": "secret"}}
+	if diff := baseline.Diff(unsaltedFingerprinted(unchanged)); len(diff.Changed) != 0 {
+		t.Errorf("Diff() against the same value changed %v, want none", diff.Changed)
+	}
+
+	rotated := &client.Environment{Data: map[string]string{"TOKEN": "rotated"}}
+	if diff := baseline.Diff(unsaltedFingerprinted(rotated)); len(diff.Changed) != 1 {
+		t.Errorf("Diff() against a rotated value changed %v, want TOKEN", diff.Changed)
+	}
+}
+
+func TestReadBaselineErrors(t *testing.T) {
+	if baseline, _, _, err := readBaseline(filepath.Join(t.TempDir(), "missing.json")); baseline != nil || err != nil {
+		t.Errorf("readBaseline() of a missing file = %v, %v; want nil, nil", baseline, err)
+	}
+
+	for _, content := range []string{
+		`{"version": 3, "fingerprints": {}}`,
+		`{"version": 2, "salt": "", "fingerprints": {}}`,
+		`{"version": 2, "salt": "not hex", "fingerprints": {}}`,
+		`not json`,
+	} {
+		if _, _, _, err := readBaseline(writeBaselineFile(t, content)); err == nil {
+			t.Errorf("readBaseline(%s) succeeded, want error", content)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// ConvertCommand flags
+var (
+	convertSource           string
+	convertTarget           string
+	convertOutputDir        string
+	convertNoMetadata       bool
+	convertDelimiter        string
+	convertNest             bool
+	convertPreserveComments bool
+	convertInferTypes       bool
+	convertTimeout          time.Duration
+)
+
+// convertCmd represents the convert command
+var convertCmd = &cobra.Command{
+	Use:   "convert",
+	Short: "Convert configuration between formats",
+	Long: `Convert a configuration file from one format to another.
+
+The input format is detected from the source file extension (.env, .json, .yaml/.yml).
+Nested JSON/YAML documents are flattened into keys joined with the flatten delimiter
+(default "__", so database.host becomes database__host). With --nest, JSON/YAML targets
+rebuild the nesting by splitting keys on the same delimiter. The target is given as
+format:path, or as a path whose extension names the format; use '-' as the path to write
+to stdout.
+
+Examples:
+  go-envsync convert --from=config.yaml --to=env:.env
+  go-envsync convert --from=.env --to=json:-
+  go-envsync convert --from=config.json --to=yaml:config.yaml --no-metadata
+  go-envsync convert --from=.env --to=yaml:config.yaml --nest`,
+	RunE: runConvertCommand,
+}
+
+func init() {
+	// Add convert command to root
+	rootCmd.AddCommand(convertCmd)
+
+	// Define flags
+	convertCmd.Flags().StringVar(&convertSource, "from", "", "Configuration source to convert")
+	convertCmd.Flags().StringVar(&convertTarget, "to", "",
+		"Target format and destination (format:path, or a path with a known extension)")
+	convertCmd.Flags().StringVar(&convertOutputDir, "output-dir", ".", "Output directory for exported files")
+	convertCmd.Flags().BoolVar(&convertNoMetadata, "no-metadata", false,
+		"Omit the metadata header (.env) and metadata object (JSON/YAML) from the output")
+	convertCmd.Flags().StringVar(&convertDelimiter, "flatten-delimiter", local.FlattenDelimiter,
+		"Delimiter used to join nested JSON/YAML keys")
+	convertCmd.Flags().BoolVar(&convertNest, "nest", false,
+		"Rebuild nested JSON/YAML output by splitting keys on the flatten delimiter")
+	convertCmd.Flags().BoolVar(&convertPreserveComments, "preserve-comments", false,
+		"Carry comments above .env keys over to .env, YAML and JSON (as _comment_KEY fields) output")
+	convertCmd.Flags().BoolVar(&convertInferTypes, "infer-types", false,
+		"Write JSON/YAML values that parse cleanly as numbers, booleans or null with those types")
+	convertCmd.Flags().DurationVar(&convertTimeout, "timeout", DefaultTimeout, "Timeout for convert operations")
+
+	// Mark required flags
+	for _, name := range []string{"from", "to"} {
+		if err := convertCmd.MarkFlagRequired(name); err != nil {
+			panic(fmt.Sprintf("failed to mark '%s' flag as required: %v", name, err))
+		}
+	}
+}
+
+// runConvertCommand executes the convert command.
+func runConvertCommand(_ *cobra.Command, _ []string) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), convertTimeout)
+	defer cancel()
+
+	// Create client with providers and exporter
+	envClient := client.New()
+	envClient.SetLogger(newConsoleLogger())
+	setupProviders(envClient).SetFlattenDelimiter(convertDelimiter)
+	multiExporter := exporter.NewMultiFormatExporterWithOptions(convertOutputDir, exporter.Options{
+		NoMetadata:    convertNoMetadata,
+		NestDelimiter: nestDelimiter(convertNest, convertDelimiter),
+		InferTypes:    convertInferTypes,
+	})
+	envClient.SetExporter(multiExporter)
+
+	// Load source
+	env, err := envClient.Load(ctx, client.LoadOptions{
+		Sources:          []string{convertSource},
+		PreserveComments: convertPreserveComments,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	multiExporter.SetComments(env.Comments)
+
+	// Export to target
+	written, err := env.ExportChanged(ctx, convertTarget)
+	if err != nil {
+		return fmt.Errorf("failed to convert configuration: %w", err)
+	}
+
+	// Report result unless writing to stdout
+	switch {
+	case strings.HasSuffix(convertTarget, ":"+exporter.StdoutPath):
+	case written:
+		fmt.Fprintf(progress(os.Stdout), "Converted %d keys from %s to %s\n", env.Size(), convertSource, convertTarget)
+	default:
+		fmt.Fprintf(progress(os.Stdout), "Unchanged %s (%d keys from %s)\n", convertTarget, env.Size(), convertSource)
+	}
+
+	return nil
+}
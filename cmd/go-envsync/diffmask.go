@@ -0,0 +1,174 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// Line patterns of each export format, capturing the text up to and including the key, the
+// key itself, the separator, and the value
+var (
+	envDiffLineRegex        = regexp.MustCompile(`^(\s*(?:export\s+)?)([^=\s#]+)(\s*=\s*)(.*)$`)
+	composeDiffLineRegex    = regexp.MustCompile(`^(\s*-\s*["']?)([^="'\s]+)(=)(.*)$`)
+	jsonDiffLineRegex       = regexp.MustCompile(`^(\s*")((?:[^"\\]|\\.)*)(":\s*)(.*?),?$`)
+	yamlDiffLineRegex       = regexp.MustCompile(`^(\s*["']?)([^"':#\s][^"':#]*?)(["']?:\s+)(.*)$`)
+	propertiesDiffLineRegex = regexp.MustCompile(`^(\s*)((?:[^=:\s\\#!]|\\.)+)(\s*[=:]\s*|\s+)(.*)$`)
+	xmlDiffLineRegex        = regexp.MustCompile(`^(\s*<entry key=")([^"]*)(">)(.*?)(?:</entry>)?$`)
+)
+
+// diffMasker masks the values of sensitive keys in the lines of a dry-run diff of one export
+// format. Lines are matched by the key the format writes them under, so removed lines are
+// masked as well as added ones; the sensitive values of both the configuration and the
+// existing file are also replaced wherever else they appear, such as in continuation lines
+// of multiline values.
+type diffMasker struct {
+	format   string
+	masker   *client.Masker
+	replacer *strings.Replacer
+}
+
+// newDiffMasker returns a masker for the diff between the existing content of an export
+// destination and the configuration about to be written there in format.
+func newDiffMasker(format, existing string, config map[string]string, masker *client.Masker) *diffMasker {
+	values := make(map[string]bool)
+	collect := func(data map[string]string) {
+		for key, value := range data {
+			if !masker.ShouldMask(key) {
+				continue
+			}
+			for _, part := range append([]string{value}, strings.Split(value, "\n")...) {
+				if len(strings.TrimSpace(part)) >= minMaskedValueLength {
+					values[part] = true
+				}
+			}
+		}
+	}
+	collect(config)
+	collect(parseExisting(format, existing))
+
+	// Replace longer values first, so a value containing another is masked whole
+	sorted := make([]string, 0, len(values))
+	for value := range values {
+		sorted = append(sorted, value)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) > len(sorted[j])
+		}
+		return sorted[i] < sorted[j]
+	})
+	replacements := make([]string, 0, 2*len(sorted))
+	for _, value := range sorted {
+		replacements = append(replacements, value, client.MaskedValue)
+	}
+
+	return &diffMasker{format: format, masker: masker, replacer: strings.NewReplacer(replacements...)}
+}
+
+// parseExisting parses the existing content of an export destination into keys and values,
+// returning nil when the format cannot be read back or the content does not parse.
+func parseExisting(format, existing string) map[string]string {
+	if existing == "" {
+		return nil
+	}
+
+	switch format {
+	case exporter.FormatEnv, exporter.FormatJSON, exporter.FormatYAML, exporter.FormatProperties,
+		exporter.FormatCSV:
+		config, err := local.ParseDocument([]byte(existing), format)
+		if err != nil {
+			return nil
+		}
+		return config
+	case exporter.FormatXML:
+		var document struct {
+			Entries []struct {
+				Key   string `xml:"key,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"entry"`
+		}
+		if err := xml.Unmarshal([]byte(existing), &document); err != nil {
+			return nil
+		}
+		config := make(map[string]string, len(document.Entries))
+		for _, entry := range document.Entries {
+			config[entry.Key] = entry.Value
+		}
+		return config
+	default:
+		return nil
+	}
+}
+
+// mask masks the value of a diff line, without its +, - or space prefix, written under a
+// sensitive key, then replaces the sensitive values left anywhere in it.
+func (m *diffMasker) mask(line string) string {
+	return m.replacer.Replace(m.maskKeyedLine(line))
+}
+
+// maskKeyedLine replaces the value of a line whose key, as the format writes it, is sensitive.
+func (m *diffMasker) maskKeyedLine(line string) string {
+	switch m.format {
+	case exporter.FormatCSV:
+		return m.maskCSVLine(line)
+	case exporter.FormatXML:
+		return m.maskPattern(line, xmlDiffLineRegex, "</entry>")
+	case exporter.FormatJSON:
+		return m.maskPattern(line, jsonDiffLineRegex, "")
+	case exporter.FormatYAML, exporter.FormatK8sSecret:
+		return m.maskPattern(line, yamlDiffLineRegex, "")
+	case exporter.FormatComposeEnv:
+		return m.maskPattern(line, composeDiffLineRegex, "")
+	case exporter.FormatProperties:
+		return m.maskPattern(line, propertiesDiffLineRegex, "")
+	default:
+		return m.maskPattern(line, envDiffLineRegex, "")
+	}
+}
+
+// maskPattern masks the value captured by pattern when its key is sensitive, appending
+// suffix after the mask. Values opening a nested object or list are left alone.
+func (m *diffMasker) maskPattern(line string, pattern *regexp.Regexp, suffix string) string {
+	match := pattern.FindStringSubmatch(line)
+	if match == nil || !m.masker.ShouldMask(strings.TrimSpace(match[2])) {
+		return line
+	}
+
+	value := strings.TrimSpace(match[4])
+	if value == "" || value == "{" || value == "[" {
+		return line
+	}
+	return match[1] + match[2] + match[3] + client.MaskedValue + suffix
+}
+
+// maskCSVLine masks the value column of a CSV row whose key column is sensitive. The header
+// row is left alone.
+func (m *diffMasker) maskCSVLine(line string) string {
+	reader := csv.NewReader(strings.NewReader(line))
+	reader.FieldsPerRecord = -1
+	record, err := reader.Read()
+	if err != nil || len(record) < 2 {
+		return line
+	}
+
+	key := record[0]
+	if (key == exporter.CSVKeyColumn && record[1] == exporter.CSVValueColumn) || !m.masker.ShouldMask(key) {
+		return line
+	}
+
+	var masked strings.Builder
+	writer := csv.NewWriter(&masked)
+	if err := writer.Write([]string{key, client.MaskedValue}); err != nil {
+		return line
+	}
+	writer.Flush()
+	return strings.TrimSuffix(masked.String(), "\n")
+}
@@ -0,0 +1,211 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/providers/registry"
+)
+
+// Constants for doctor command
+const (
+	// DefaultDoctorTimeout is the default timeout for each provider health check.
+	DefaultDoctorTimeout = 10 * time.Second
+
+	// ConfigAssignmentParts defines the expected number of parts in key=value.
+	ConfigAssignmentParts = 2
+
+	// ProviderConfigKeyParts defines the expected number of parts in provider.key.
+	ProviderConfigKeyParts = 2
+
+	// StatusColumnLength defines the length for the status column display.
+	StatusColumnLength = 12
+
+	// HealthStatusReachable indicates a provider passed its health check.
+	HealthStatusReachable = "reachable"
+
+	// HealthStatusUnreachable indicates a provider failed its health check.
+	HealthStatusUnreachable = "unreachable"
+
+	// HealthStatusSkipped indicates a provider does not support health checks.
+	HealthStatusSkipped = "skipped"
+
+	// HealthStatusError indicates a provider could not be created.
+	HealthStatusError = "error"
+)
+
+// DoctorCommand flags
+var (
+	doctorProviders []string
+	doctorConfig    []string
+	doctorTimeout   time.Duration
+)
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check connectivity of configuration providers",
+	Long: `Check that registered providers can reach their backends.
+
+Each provider is created with the supplied configuration and probed with its
+health check. Providers that do not support health checks, or cannot check their backend
+yet, are reported as skipped.
+The command exits with an error if any checked provider is unreachable.
+
+Examples:
+  go-envsync doctor
+  go-envsync doctor --provider=local --config=local.base_path=./config
+  go-envsync doctor --provider=vault --config=vault.token=s.xxxx --config=vault.address=https://vault:8200`,
+	RunE: runDoctorCommand,
+}
+
+func init() {
+	// Add doctor command to root
+	rootCmd.AddCommand(doctorCmd)
+
+	// Define flags
+	doctorCmd.Flags().StringSliceVar(&doctorProviders, "provider", []string{},
+		"Providers to check (default: all registered providers)")
+	doctorCmd.Flags().StringArrayVar(&doctorConfig, "config", []string{},
+		"Provider configuration as provider.key=value (repeatable)")
+	doctorCmd.Flags().DurationVar(&doctorTimeout, "timeout", DefaultDoctorTimeout, "Timeout for each health check")
+}
+
+// healthResult holds the outcome of a provider health check.
+type healthResult struct {
+	Provider string
+	Status   string
+	Detail   string
+}
+
+// runDoctorCommand executes the doctor command.
+func runDoctorCommand(_ *cobra.Command, _ []string) error {
+	// Parse provider configuration
+	configs, err := parseProviderConfigs(doctorConfig)
+	if err != nil {
+		return err
+	}
+
+	// Determine which providers to check
+	names, err := resolveDoctorProviders(doctorProviders)
+	if err != nil {
+		return err
+	}
+
+	// Run checks
+	unreachable := 0
+	results := make([]healthResult, 0, len(names))
+	for _, name := range names {
+		result := checkProviderHealth(name, configs[name])
+		if result.Status == HealthStatusUnreachable || result.Status == HealthStatusError {
+			unreachable++
+		}
+		results = append(results, result)
+	}
+
+	// Display results
+	fmt.Printf("%-*s %-*s %s\n", MinProviderNameLength, "PROVIDER", StatusColumnLength, "STATUS", "DETAILS")
+	fmt.Printf("%s %s %s\n",
+		strings.Repeat("-", MinProviderNameLength),
+		strings.Repeat("-", StatusColumnLength),
+		strings.Repeat("-", MaxDescriptionLength))
+	for _, result := range results {
+		fmt.Printf("%-*s %-*s %s\n", MinProviderNameLength, result.Provider, StatusColumnLength, result.Status, result.Detail)
+	}
+
+	if unreachable > 0 {
+		return fmt.Errorf("%d of %d providers are unreachable", unreachable, len(results))
+	}
+
+	return nil
+}
+
+// resolveDoctorProviders returns the sorted canonical names of the providers to check.
+func resolveDoctorProviders(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		var names []string
+		for _, info := range registry.ListProviders() {
+			names = append(names, info.Name)
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+
+	names := make([]string, 0, len(requested))
+	for _, name := range requested {
+		info, err := registry.GetProvider(name)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, info.Name)
+	}
+
+	return names, nil
+}
+
+// checkProviderHealth creates a provider and runs its health check if supported.
+func checkProviderHealth(name string, config map[string]interface{}) healthResult {
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+
+	provider, err := registry.CreateProvider(name, config)
+	if err != nil {
+		return healthResult{Provider: name, Status: HealthStatusError, Detail: err.Error()}
+	}
+
+	checker, ok := provider.(client.HealthChecker)
+	if !ok {
+		return healthResult{Provider: name, Status: HealthStatusSkipped, Detail: "health check not supported"}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+	defer cancel()
+
+	if err := checker.HealthCheck(ctx); err != nil {
+		if errors.Is(err, client.ErrHealthCheckUnsupported) {
+			return healthResult{Provider: name, Status: HealthStatusSkipped, Detail: err.Error()}
+		}
+		return healthResult{Provider: name, Status: HealthStatusUnreachable, Detail: err.Error()}
+	}
+
+	return healthResult{Provider: name, Status: HealthStatusReachable, Detail: "ok"}
+}
+
+// parseProviderConfigs parses provider.key=value assignments into per-provider configuration maps.
+// Provider aliases are resolved to canonical provider names.
+func parseProviderConfigs(assignments []string) (map[string]map[string]interface{}, error) {
+	configs := make(map[string]map[string]interface{})
+
+	for _, assignment := range assignments {
+		parts := strings.SplitN(assignment, "=", ConfigAssignmentParts)
+		if len(parts) != ConfigAssignmentParts {
+			return nil, fmt.Errorf("invalid config assignment, expected 'provider.key=value', got: %s", assignment)
+		}
+
+		keyParts := strings.SplitN(parts[0], ".", ProviderConfigKeyParts)
+		if len(keyParts) != ProviderConfigKeyParts || keyParts[0] == "" || keyParts[1] == "" {
+			return nil, fmt.Errorf("invalid config key, expected 'provider.key', got: %s", parts[0])
+		}
+
+		info, err := registry.GetProvider(keyParts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		if configs[info.Name] == nil {
+			configs[info.Name] = make(map[string]interface{})
+		}
+		configs[info.Name][keyParts[1]] = parts[1]
+	}
+
+	return configs, nil
+}
@@ -0,0 +1,262 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+)
+
+// Constants for dry-run diffs
+const (
+	// DiffContextLines is the number of unchanged lines shown around each change.
+	DiffContextLines = 3
+
+	// devNull names the missing side of a diff for files that do not exist yet.
+	devNull = "/dev/null"
+
+	// minMaskedValueLength is the shortest sensitive value replaced wherever it appears in a diff;
+	// shorter values would mask unrelated text.
+	minMaskedValueLength = 4
+
+	// maxDiffCells bounds the longest-common-subsequence table of a line diff, in cells.
+	// Larger diffs, after skipping their common leading and trailing lines, show every
+	// remaining old line as removed and every new line as added.
+	maxDiffCells = 4 * 1024 * 1024
+)
+
+// diffLine is one line of a line diff: an unchanged line (' '), a removed line ('-') or an
+// added line ('+').
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// printDryRunDiffs renders every export target in memory and prints a unified diff against the
+// file it would write, without writing anything. Values of sensitive keys are masked on both
+// sides of the diff.
+func printDryRunDiffs(
+	w io.Writer, envClient *client.Client, env *client.Environment, targets []string, masker *client.Masker,
+) error {
+	multiExporter, ok := envClient.Exporter().(*exporter.MultiFormatExporter)
+	if !ok {
+		fmt.Fprintln(w, "Encrypted exports cannot be diffed; no diff shown")
+		return nil
+	}
+
+	for _, target := range targets {
+		format, filePath, err := multiExporter.ResolveDestination(target)
+		if err != nil {
+			return fmt.Errorf("target %s: %w", target, err)
+		}
+
+		content, err := multiExporter.Render(env.Data, format)
+		if err != nil {
+			return fmt.Errorf("target %s: %w", target, err)
+		}
+
+		if filePath == exporter.StdoutPath {
+			fmt.Fprintf(w, "Would write %d bytes to stdout\n", len(content))
+			continue
+		}
+
+		// A missing file diffs against empty content
+		oldName := filePath
+		// #nosec G304 - filePath is the export destination given on the command line
+		existing, err := os.ReadFile(filePath)
+		switch {
+		case os.IsNotExist(err):
+			oldName = devNull
+		case err != nil:
+			return fmt.Errorf("target %s: failed to read existing file: %w", target, err)
+		}
+
+		var mask func(string) string
+		if masker != nil {
+			mask = newDiffMasker(format, string(existing), env.Data, masker).mask
+		}
+
+		diff := unifiedDiff(oldName, filePath, string(existing), string(content), mask)
+		if diff == "" {
+			fmt.Fprintf(w, "No changes to %s\n", filePath)
+			continue
+		}
+		fmt.Fprint(w, diff)
+	}
+
+	return nil
+}
+
+// unifiedDiff returns a unified diff turning before into after, or an empty string when they
+// are equal. When mask is set, it rewrites the text of every line shown.
+func unifiedDiff(oldName, newName, before, after string, mask func(string) string) string {
+	if before == after {
+		return ""
+	}
+
+	lines := diffLines(splitLines(before), splitLines(after))
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", oldName, newName)
+
+	// Group changes closer than twice the context into one hunk
+	for start := 0; start < len(lines); {
+		if lines[start].op == ' ' {
+			start++
+			continue
+		}
+
+		hunkStart := max(start-DiffContextLines, 0)
+		end := start
+		for i := start; i < len(lines); i++ {
+			if lines[i].op != ' ' {
+				end = i
+				continue
+			}
+			if i-end > 2*DiffContextLines {
+				break
+			}
+		}
+		hunkEnd := min(end+DiffContextLines+1, len(lines))
+
+		writeHunk(&out, lines, hunkStart, hunkEnd, mask)
+		start = hunkEnd
+	}
+
+	return out.String()
+}
+
+// writeHunk writes lines[from:to] as one hunk with its @@ header, rewriting each line's
+// text with mask when it is set.
+func writeHunk(out *strings.Builder, lines []diffLine, from, to int, mask func(string) string) {
+	// Count the lines of each side before and inside the hunk
+	oldStart, newStart := 1, 1
+	for _, line := range lines[:from] {
+		if line.op != '+' {
+			oldStart++
+		}
+		if line.op != '-' {
+			newStart++
+		}
+	}
+	oldCount, newCount := 0, 0
+	for _, line := range lines[from:to] {
+		if line.op != '+' {
+			oldCount++
+		}
+		if line.op != '-' {
+			newCount++
+		}
+	}
+
+	// An empty side starts before its first line
+	if oldCount == 0 {
+		oldStart--
+	}
+	if newCount == 0 {
+		newStart--
+	}
+
+	fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+	for _, line := range lines[from:to] {
+		text := line.text
+		if mask != nil {
+			text = mask(text)
+		}
+		fmt.Fprintf(out, "%c%s\n", line.op, text)
+	}
+}
+
+// diffLines returns the line diff of before and after using their longest common subsequence.
+// Common leading and trailing lines are matched first; when the lines between them would need
+// a table larger than maxDiffCells, they are all shown as removed and added instead.
+func diffLines(before, after []string) []diffLine {
+	// Match common leading and trailing lines
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix &&
+		before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]diffLine, 0, len(before)+len(after))
+	for _, text := range before[:prefix] {
+		lines = append(lines, diffLine{op: ' ', text: text})
+	}
+	lines = append(lines, diffMiddle(before[prefix:len(before)-suffix], after[prefix:len(after)-suffix])...)
+	for _, text := range before[len(before)-suffix:] {
+		lines = append(lines, diffLine{op: ' ', text: text})
+	}
+
+	return lines
+}
+
+// diffMiddle returns the line diff of before and after, which share no leading or trailing lines.
+func diffMiddle(before, after []string) []diffLine {
+	lines := make([]diffLine, 0, len(before)+len(after))
+
+	// Fall back to replacing every line when the table would be too large
+	if (len(before)+1)*(len(after)+1) > maxDiffCells {
+		for _, text := range before {
+			lines = append(lines, diffLine{op: '-', text: text})
+		}
+		for _, text := range after {
+			lines = append(lines, diffLine{op: '+', text: text})
+		}
+		return lines
+	}
+
+	// common[i][j] is the length of the longest common subsequence of before[i:] and after[j:]
+	common := make([][]int, len(before)+1)
+	for i := range common {
+		common[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			if before[i] == after[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(before) && j < len(after) {
+		switch {
+		case before[i] == after[j]:
+			lines = append(lines, diffLine{op: ' ', text: before[i]})
+			i++
+			j++
+		case common[i+1][j] >= common[i][j+1]:
+			lines = append(lines, diffLine{op: '-', text: before[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{op: '+', text: after[j]})
+			j++
+		}
+	}
+	for ; i < len(before); i++ {
+		lines = append(lines, diffLine{op: '-', text: before[i]})
+	}
+	for ; j < len(after); j++ {
+		lines = append(lines, diffLine{op: '+', text: after[j]})
+	}
+
+	return lines
+}
+
+// splitLines splits content into lines without their line endings.
+func splitLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
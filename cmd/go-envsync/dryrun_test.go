@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+)
+
+func TestDryRunDiffMasksSecretsInEveryFormat(t *testing.T) {
+	masker, err := client.NewDefaultMasker()
+	if err != nil {
+		t.Fatalf("NewDefaultMasker() error = %v", err)
+	}
+
+	before := map[string]string{"DB_HOST": "old-host", "DB_PASSWORD": "old-s3cret-pass", "API_TOKEN": "old-tok3n"}
+	after := map[string]string{"DB_HOST": "new-host", "DB_PASSWORD": "new-s3cret-pass", "API_TOKEN": "new-tok3n"}
+	secrets := []string{"old-s3cret-pass", "new-s3cret-pass", "old-tok3n", "new-tok3n"}
+
+	e := exporter.NewMultiFormatExporterWithOptions(".", exporter.Options{NoMetadata: true})
+	for _, format := range []string{
+		exporter.FormatEnv, exporter.FormatJSON, exporter.FormatYAML, exporter.FormatProperties,
+		exporter.FormatXML, exporter.FormatCSV, exporter.FormatComposeEnv,
+	} {
+		t.Run(format, func(t *testing.T) {
+			existing, err := e.Render(before, format)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			content, err := e.Render(after, format)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			mask := newDiffMasker(format, string(existing), after, masker).mask
+			diff := unifiedDiff("old", "new", string(existing), string(content), mask)
+
+			for _, secret := range secrets {
+				if strings.Contains(diff, secret) {
+					t.Errorf("diff leaks %s:\n%s", secret, diff)
+				}
+			}
+			for _, visible := range []string{"old-host", "new-host", client.MaskedValue} {
+				if !strings.Contains(diff, visible) {
+					t.Errorf("diff does not show %s:\n%s", visible, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestDryRunDiffMasksOldMultilineValues(t *testing.T) {
+	masker, err := client.NewDefaultMasker()
+	if err != nil {
+		t.Fatalf("NewDefaultMasker() error = %v", err)
+	}
+
+	existing := "PRIVATE_KEY=\"-----BEGIN KEY-----\nc2VjcmV0LWtleS1ib2R5\n-----END KEY-----\"\n"
+	content := "PRIVATE_KEY=rotated\n"
+
+	mask := newDiffMasker(exporter.FormatEnv, existing, map[string]string{"PRIVATE_KEY": "rotated"}, masker).mask
+	diff := unifiedDiff("old", "new", existing, content, mask)
+	if strings.Contains(diff, "c2VjcmV0LWtleS1ib2R5") || strings.Contains(diff, "rotated") {
+		t.Errorf("diff leaks the key:\n%s", diff)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	lines := diffLines([]string{"a", "b", "c", "d"}, []string{"a", "c", "x", "d"})
+
+	var got strings.Builder
+	for _, line := range lines {
+		fmt.Fprintf(&got, "%c%s ", line.op, line.text)
+	}
+	if want := " a -b  c +x  d "; got.String() != want {
+		t.Errorf("diffLines() = %q, want %q", got.String(), want)
+	}
+}
+
+func TestDiffLinesFallsBackForLargeInputs(t *testing.T) {
+	size := 3000
+	before := make([]string, size)
+	after := make([]string, size)
+	for i := range before {
+		before[i] = fmt.Sprintf("OLD_%d=%d", i, i)
+		after[i] = fmt.Sprintf("NEW_%d=%d", i, i)
+	}
+	before[0], after[0] = "SAME=1", "SAME=1"
+
+	lines := diffLines(before, after)
+	if len(lines) != 2*size-1 || lines[0].op != ' ' || lines[1].op != '-' || lines[size].op != '+' {
+		t.Errorf("diffLines() = %d lines starting %v, want the common line then removals, then additions",
+			len(lines), lines[:2])
+	}
+}
@@ -0,0 +1,184 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+)
+
+// ExportCommand flags
+var (
+	exportSources       []string
+	exportTargets       []string
+	exportSchema        string
+	exportMergeStrategy string
+	exportOutputDir     string
+	exportNoMetadata    bool
+	exportPrefix        string
+	exportTimeout       time.Duration
+	exportAnnotate      bool
+	exportArrayKeys     []string
+	exportInferTypes    bool
+	exportK8sSecretName string
+	exportK8sNamespace  string
+	exportNest          bool
+	exportNestDelimiter string
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Load configuration and export it to one or more targets",
+	Long: `Load configuration from one or more sources and export it to every --to target.
+
+Each target is given as format:path, or as a path whose extension names the format
+(config.yaml); use '-' as the path to write to stdout.
+Validation only runs when --validate is given.
+
+Examples:
+  go-envsync export --from=.env --to=json:config.json
+  go-envsync export --from=.env --from=.env.local --to=json:config.json --to=yaml:config.yaml
+  go-envsync export --from=.env --validate=schema.json --to=env:-`,
+	RunE: runExportCommand,
+}
+
+func init() {
+	// Add export command to root
+	rootCmd.AddCommand(exportCmd)
+
+	// Define flags
+	exportCmd.Flags().StringSliceVar(&exportSources, "from", []string{}, "Configuration sources to load from")
+	exportCmd.Flags().StringArrayVar(&exportTargets, "to", []string{},
+		"Target format and destination (format:path, or a path with a known extension), may be repeated")
+	exportCmd.Flags().StringVar(&exportSchema, "validate", "", "JSON schema file for validation")
+	exportCmd.Flags().StringVar(&exportMergeStrategy, "merge-strategy", DefaultMergeStrategy,
+		"Merge strategy for multiple sources (override, preserve, error, conflict, priority)")
+	exportCmd.Flags().StringVar(&exportOutputDir, "output-dir", ".", "Output directory for exported files")
+	exportCmd.Flags().BoolVar(&exportNoMetadata, "no-metadata", false,
+		"Omit the metadata header (.env) and metadata object (JSON/YAML) from exported files")
+	exportCmd.Flags().StringVar(&exportPrefix, "export-prefix", "", "Prefix added to every exported key")
+	exportCmd.Flags().BoolVar(&exportAnnotate, "annotate-with-schema", false,
+		"Write schema descriptions and required markers as comments in .env exports (requires --validate)")
+	exportCmd.Flags().StringArrayVar(&exportArrayKeys, "array-key", []string{},
+		"Export KEY as a JSON/YAML array split on a delimiter, as KEY or KEY=DELIMITER (default ','), may be repeated")
+	exportCmd.Flags().BoolVar(&exportInferTypes, "infer-types", false,
+		"Write JSON/YAML values that parse cleanly as numbers, booleans or null with those types")
+	exportCmd.Flags().BoolVar(&exportNest, "nest", false,
+		"Rebuild nested JSON/YAML objects from keys joined with the nest delimiter")
+	exportCmd.Flags().StringVar(&exportNestDelimiter, "nest-delimiter", exporter.DefaultNestDelimiter,
+		"Delimiter on which --nest splits keys (e.g. '.' for database.host)")
+	exportCmd.Flags().StringVar(&exportK8sSecretName, "k8s-secret-name", exporter.DefaultK8sSecretName,
+		"metadata.name of k8s-secret exports")
+	exportCmd.Flags().StringVar(&exportK8sNamespace, "k8s-namespace", "",
+		"metadata.namespace of k8s-secret exports (omitted when empty)")
+	exportCmd.Flags().DurationVar(&exportTimeout, "timeout", DefaultTimeout, "Timeout for export operations")
+
+	// Mark required flags
+	for _, name := range []string{"from", "to"} {
+		if err := exportCmd.MarkFlagRequired(name); err != nil {
+			panic(fmt.Sprintf("failed to mark '%s' flag as required: %v", name, err))
+		}
+	}
+}
+
+// runExportCommand executes the export command.
+func runExportCommand(_ *cobra.Command, _ []string) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	// Expand glob patterns in local sources
+	sources, err := expandSourceGlobs(exportSources)
+	if err != nil {
+		return err
+	}
+	exportSources = sources
+
+	if len(exportSources) > MaxSources {
+		return fmt.Errorf("too many sources: %d > %d", len(exportSources), MaxSources)
+	}
+
+	// Parse merge strategy
+	mergeStrategy, err := parseMergeStrategy(exportMergeStrategy)
+	if err != nil {
+		return err
+	}
+
+	// Parse array keys
+	arrayKeys, err := parseArrayKeys(exportArrayKeys)
+	if err != nil {
+		return err
+	}
+
+	// Create client with providers and exporter
+	envClient := client.New()
+	envClient.SetLogger(newConsoleLogger())
+	setupProviders(envClient)
+	multiExporter := exporter.NewMultiFormatExporterWithOptions(exportOutputDir, exporter.Options{
+		NoMetadata:    exportNoMetadata,
+		KeyPrefix:     exportPrefix,
+		ArrayKeys:     arrayKeys,
+		InferTypes:    exportInferTypes,
+		Nest:          exportNest,
+		NestDelimiter: nestDelimiter(exportNest, exportNestDelimiter),
+		K8sSecretName: exportK8sSecretName,
+		K8sNamespace:  exportK8sNamespace,
+	})
+	envClient.SetExporter(multiExporter)
+
+	// Annotate .env output with schema descriptions if requested
+	if exportAnnotate {
+		if exportSchema == "" {
+			return fmt.Errorf("--annotate-with-schema requires --validate")
+		}
+		annotations, err := exporter.LoadSchemaAnnotations(exportSchema)
+		if err != nil {
+			return err
+		}
+		multiExporter.SetAnnotations(annotations)
+	}
+
+	// Setup validator only when requested
+	if exportSchema != "" {
+		if err := setupValidator(envClient, []string{exportSchema}, false); err != nil {
+			return fmt.Errorf("failed to setup validator: %w", err)
+		}
+	}
+
+	// Load sources
+	env, err := envClient.Load(ctx, client.LoadOptions{
+		Sources:       exportSources,
+		Schema:        exportSchema,
+		MergeStrategy: mergeStrategy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Export to every target
+	for _, target := range exportTargets {
+		written, err := env.ExportChanged(ctx, target)
+		if err != nil {
+			return fmt.Errorf("failed to export configuration to %s: %w", target, err)
+		}
+
+		// Report result unless writing to stdout
+		switch {
+		case strings.HasSuffix(target, ":"+exporter.StdoutPath):
+		case written:
+			fmt.Fprintf(progress(os.Stdout), "Exported %d keys to %s\n", env.Size(), target)
+		default:
+			fmt.Fprintf(progress(os.Stdout), "Unchanged %s (%d keys)\n", target, env.Size())
+		}
+	}
+
+	return nil
+}
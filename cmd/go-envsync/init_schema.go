@@ -0,0 +1,117 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/validator"
+)
+
+// Constants for init-schema command
+const (
+	// SchemaFilePermissions defines the file permissions for generated schema files.
+	SchemaFilePermissions = 0o644
+
+	// StdoutDestination is the destination value that writes to standard output.
+	StdoutDestination = "-"
+)
+
+// InitSchemaCommand flags
+var (
+	initSchemaSources       []string
+	initSchemaOutput        string
+	initSchemaStrict        bool
+	initSchemaMergeStrategy string
+	initSchemaTimeout       time.Duration
+)
+
+// initSchemaCmd represents the init-schema command
+var initSchemaCmd = &cobra.Command{
+	Use:   "init-schema",
+	Short: "Generate a starter JSON schema from loaded configuration",
+	Long: `Generate a starter JSON Schema from one or more configuration sources.
+
+Every observed key becomes a required string property. Values that look like
+integers, decimal numbers, URLs, or email addresses get pattern/format hints.
+The generated schema can be used directly with 'load --validate'.
+
+Examples:
+  go-envsync init-schema --from=.env
+  go-envsync init-schema --from=.env --output=.envschema.json --strict`,
+	RunE: runInitSchemaCommand,
+}
+
+func init() {
+	// Add init-schema command to root
+	rootCmd.AddCommand(initSchemaCmd)
+
+	// Define flags
+	initSchemaCmd.Flags().StringSliceVar(&initSchemaSources, "from", []string{}, "Configuration sources to load from")
+	initSchemaCmd.Flags().StringVar(&initSchemaOutput, "output", StdoutDestination,
+		"Output file for the generated schema ('-' for stdout)")
+	initSchemaCmd.Flags().BoolVar(&initSchemaStrict, "strict", false,
+		"Disallow keys not present in the schema (additionalProperties: false)")
+	initSchemaCmd.Flags().StringVar(&initSchemaMergeStrategy, "merge-strategy", DefaultMergeStrategy,
+		"Merge strategy for multiple sources (override, preserve, error, conflict, priority)")
+	initSchemaCmd.Flags().DurationVar(&initSchemaTimeout, "timeout", DefaultTimeout, "Timeout for load operations")
+
+	// Mark required flags
+	if err := initSchemaCmd.MarkFlagRequired("from"); err != nil {
+		panic(fmt.Sprintf("failed to mark 'from' flag as required: %v", err))
+	}
+}
+
+// runInitSchemaCommand executes the init-schema command.
+func runInitSchemaCommand(_ *cobra.Command, _ []string) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), initSchemaTimeout)
+	defer cancel()
+
+	if len(initSchemaSources) > MaxSources {
+		return fmt.Errorf("too many sources: %d > %d", len(initSchemaSources), MaxSources)
+	}
+
+	// Parse merge strategy
+	mergeStrategy, err := parseMergeStrategy(initSchemaMergeStrategy)
+	if err != nil {
+		return err
+	}
+
+	// Create client and load configuration
+	envClient := client.New()
+	envClient.SetLogger(newConsoleLogger())
+	setupProviders(envClient)
+
+	env, err := envClient.Load(ctx, client.LoadOptions{
+		Sources:       initSchemaSources,
+		MergeStrategy: mergeStrategy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Generate schema
+	data, err := validator.MarshalSchema(validator.GenerateSchema(env.Data, initSchemaStrict))
+	if err != nil {
+		return err
+	}
+
+	// Write schema
+	if initSchemaOutput == StdoutDestination || initSchemaOutput == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(initSchemaOutput, data, SchemaFilePermissions); err != nil {
+		return fmt.Errorf("failed to write schema file %s: %w", initSchemaOutput, err)
+	}
+
+	fmt.Fprintf(progress(os.Stdout), "Schema with %d properties written to %s\n", env.Size(), initSchemaOutput)
+	return nil
+}
@@ -3,15 +3,26 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/encryption"
 	"github.com/Gosayram/go-envsync/pkg/exporter"
+	"github.com/Gosayram/go-envsync/pkg/providers/archive"
+	"github.com/Gosayram/go-envsync/pkg/providers/dockersecrets"
 	"github.com/Gosayram/go-envsync/pkg/providers/local"
+	"github.com/Gosayram/go-envsync/pkg/providers/plugin"
+	"github.com/Gosayram/go-envsync/pkg/providers/registry"
+	"github.com/Gosayram/go-envsync/pkg/providers/sops"
 	"github.com/Gosayram/go-envsync/pkg/validator"
 )
 
@@ -31,19 +42,105 @@ const (
 
 	// SourceFormatParts defines the expected number of parts in source format.
 	SourceFormatParts = 2
+
+	// DefaultArrayDelimiter is the delimiter used by --array-key when none is given.
+	DefaultArrayDelimiter = ","
+
+	// DefaultProfileEnvVar is the environment variable the profile is read from when
+	// --profile is not given.
+	DefaultProfileEnvVar = "APP_ENV"
 )
 
 // LoadCommand flags
 var (
 	loadSources       []string
-	loadSchema        string
-	loadExport        string
+	loadSchemas       []string
+	loadExport        []string
 	loadMergeStrategy string
 	loadTimeout       time.Duration
+	loadProviderTimes []string
+	loadPlugins       []string
 	loadOutputDir     string
 	loadDryRun        bool
+
+	loadExportTemplate       string
+	loadExportTemplateHeader string
+	loadExportTemplateFooter string
+	loadNoMetadata           bool
+	loadExportPrefix         string
+	loadNormalizeKeys        string
+	loadDedupeCase           string
+	loadRenames              []string
+	loadExpandOSEnv          bool
+	loadResolveRefs          bool
+	loadExpandBareOSEnv      bool
+	loadStrictExpansion      bool
+	loadTemplate             bool
+	loadProfile              string
+	loadProfileEnvVar        string
+	loadProfilesConfig       string
+	loadMaskKeys             []string
+	loadNoMask               bool
+	loadOnly                 []string
+	loadExclude              []string
+	loadOnDuplicate          string
+	loadEncrypt              bool
+	loadEncryptKey           string
+	loadEncryptKeyFile       string
+	loadGroup                bool
+	loadResolveFileRefs      bool
+	loadFailFast             bool
+	loadStrict               bool
+	loadRejectUnknownKeys    bool
+	loadStdinFormat          string
+	loadShowResolved         bool
+	loadExplain              bool
+	loadMergeReport          bool
+	loadBaseline             string
+	loadTransforms           string
+	loadAgainstExample       string
+	loadWarnExtraKeys        bool
+	loadBaselineAll          bool
+	loadCheck                bool
+	loadFlattenDelimiter     string
+	loadNest                 bool
+	loadAnnotate             bool
+	loadApplyDefaults        bool
+	loadAllowInsecurePerms   bool
+	loadArrayKeys            []string
+	loadInferTypes           bool
+	loadOutput               string
+	loadKeyCase              string
+	loadMaxKeys              int
+	loadMaxKeyLength         int
+	loadMaxValueSize         int
+	loadK8sSecretName        string
+	loadK8sNamespace         string
+	loadRequireIf            []string
+	loadRequire              []string
+	loadAllowKeys            []string
+	loadDenyKeys             []string
+	loadPromptMissing        bool
+	loadCheckEncoding        bool
 )
 
+// loadSummary is the machine-readable result of a load, printed with --output=json|yaml.
+type loadSummary struct {
+	Sources   []loadSourceSummary `json:"sources" yaml:"sources"`
+	TotalKeys int                 `json:"total_keys" yaml:"total_keys"`
+	Validated bool                `json:"validated" yaml:"validated"`
+	Warnings  []string            `json:"warnings,omitempty" yaml:"warnings,omitempty"`
+	Exports   []string            `json:"exports,omitempty" yaml:"exports,omitempty"`
+	DryRun    bool                `json:"dry_run" yaml:"dry_run"`
+}
+
+// loadSourceSummary describes a single loaded source in the load summary.
+type loadSourceSummary struct {
+	Name     string `json:"name" yaml:"name"`
+	Provider string `json:"provider" yaml:"provider"`
+	Keys     int    `json:"keys" yaml:"keys"`
+}
+
 // loadCmd represents the load command
 var loadCmd = &cobra.Command{
 	Use:   "load",
@@ -52,6 +149,8 @@ var loadCmd = &cobra.Command{
 
 Supported sources:
 - local:.env (or just .env) - Load from local .env file
+- - (a single dash) - Read from standard input, parsed per --stdin-format
+- sops:secrets.enc.yaml - Load a SOPS-encrypted file (requires the sops binary)
 - k8s:namespace/secret - Load from Kubernetes Secret (planned)
 - vault:path/to/secret - Load from HashiCorp Vault (planned)
 - s3:bucket/path - Load from AWS S3 (planned)
@@ -59,7 +158,22 @@ Supported sources:
 Examples:
   go-envsync load --from=.env --validate=./schema.json --export=json:config.json
   go-envsync load --from=.env --from=local:.env.local --export=yaml:config.yaml
-  go-envsync load --from=.env --merge-strategy=preserve --dry-run`,
+  go-envsync load --from=.env --merge-strategy=preserve --dry-run
+  go-envsync load --from=.env --from=vault:secret/app --check
+  go-envsync load --from=.env --from=vault:secret/app --timeout=2m --provider-timeout=vault=90s
+  go-envsync load --from=.env --profile=production --export=json:config.json
+  go-envsync load --from=.env --export=template:config.txt --export-template='{{.Key}}: {{.Value | quote}}'
+  ENVSYNC_ENCRYPTION_KEY=secret go-envsync load --from=.env --export=env:.env.enc --encrypt
+  go-envsync load --from=.env --export=env:.env.enc --encrypt --encrypt-key-file=passphrase.txt
+
+Encrypted exports use AES-256-GCM with a key derived from the passphrase by scrypt
+(N=32768, r=8, p=1, random 16-byte salt per file). Encrypted files are decrypted
+automatically on load using the same passphrase. Prefer $ENVSYNC_ENCRYPTION_KEY or
+--encrypt-key-file over --encrypt-key, which is visible in process listings.
+
+--timeout bounds the whole command. --provider-timeout=name=duration additionally bounds
+each load from that provider, overriding the provider's own configured timeout. Each source
+must finish within both, so a per-provider timeout can shorten but never extend --timeout.`,
 	RunE: runLoadCommand,
 }
 
@@ -68,107 +182,563 @@ func init() {
 	rootCmd.AddCommand(loadCmd)
 
 	// Define flags
+	registerLoadSourceFlags()
+	registerLoadProcessingFlags()
+	registerLoadValidationFlags()
+	registerLoadOutputFlags()
+}
+
+// registerLoadSourceFlags defines the load flags selecting and reading sources.
+func registerLoadSourceFlags() {
 	loadCmd.Flags().StringSliceVar(&loadSources, "from", []string{}, "Configuration sources to load from")
-	loadCmd.Flags().StringVar(&loadSchema, "validate", "", "JSON schema file for validation")
-	loadCmd.Flags().StringVar(&loadExport, "export", "", "Export format and destination (format:path)")
 	loadCmd.Flags().StringVar(&loadMergeStrategy, "merge-strategy", DefaultMergeStrategy,
-		"Merge strategy for multiple sources (override, preserve, error)")
+		"Merge strategy for multiple sources (override, preserve, error, conflict, priority)")
 	loadCmd.Flags().DurationVar(&loadTimeout, "timeout", DefaultTimeout, "Timeout for load operations")
-	loadCmd.Flags().StringVar(&loadOutputDir, "output-dir", ".", "Output directory for exported files")
-	loadCmd.Flags().BoolVar(&loadDryRun, "dry-run", false, "Perform a dry run without writing files")
+	loadCmd.Flags().StringSliceVar(&loadProviderTimes, "provider-timeout", []string{},
+		"Per-provider load timeout as name=duration (e.g. vault=90s), bounded by --timeout")
+	loadCmd.Flags().StringVar(&loadProfile, "profile", "",
+		"Layer each source as <source>, <source>.local, <source>.<profile> with later layers overriding, "+
+			"or select the profile of --profiles-config")
+	loadCmd.Flags().StringVar(&loadProfileEnvVar, "profile-env-var", DefaultProfileEnvVar,
+		"Environment variable the profile is read from when --profile is not given")
+	loadCmd.Flags().StringVar(&loadProfilesConfig, "profiles-config", "",
+		"YAML or JSON file of profiles with parent and sources; loads the profile's chain of sources, root first")
+	loadCmd.Flags().StringVar(&loadOnDuplicate, "on-duplicate", "ignore",
+		"Handling of keys defined more than once in a single file (ignore, warn, error)")
+	loadCmd.Flags().BoolVar(&loadResolveFileRefs, "resolve-file-refs", false,
+		"Replace values of the form @file:<path> with the file contents (paths relative to the source file)")
+	loadCmd.Flags().StringVar(&loadStdinFormat, "stdin-format", local.FormatEnv,
+		"Format of standard input when --from=- is used (env, json, yaml, properties, csv)")
+	loadCmd.Flags().StringVar(&loadFlattenDelimiter, "flatten-delimiter", local.FlattenDelimiter,
+		"Delimiter used to join nested JSON/YAML keys")
+	loadCmd.Flags().BoolVar(&loadAllowInsecurePerms, "allow-insecure-perms", false,
+		"Warn instead of failing when a local source file is world-writable")
+	loadCmd.Flags().StringArrayVar(&loadPlugins, "plugin", []string{},
+		"Register a plugin binary as provider NAME, as NAME=BINARY (sources use NAME:source), may be repeated")
+	loadCmd.Flags().BoolVar(&loadCheck, "check", false,
+		"Check that every source is resolvable without loading any values, then exit")
+	loadCmd.Flags().StringVar(&loadEncryptKey, "encrypt-key", "",
+		"Passphrase for encrypting exports and decrypting encrypted sources (default $"+encryption.KeyEnvVar+
+			"); visible in process listings, prefer --encrypt-key-file or the environment variable")
+	loadCmd.Flags().StringVar(&loadEncryptKeyFile, "encrypt-key-file", "",
+		"Read the passphrase for encrypting exports and decrypting encrypted sources from a file")
+}
 
-	// Mark required flags
-	if err := loadCmd.MarkFlagRequired("from"); err != nil {
-		// This should never happen in practice, but we need to handle the error
-		panic(fmt.Sprintf("failed to mark 'from' flag as required: %v", err))
-	}
+// registerLoadProcessingFlags defines the load flags transforming the merged configuration.
+func registerLoadProcessingFlags() {
+	loadCmd.Flags().StringVar(&loadNormalizeKeys, "normalize-keys", "",
+		"Normalize keys after loading each source (upper, lower, snake)")
+	loadCmd.Flags().StringVar(&loadDedupeCase, "dedupe-case", "",
+		"Collapse keys differing only by case into upper or lower case, keeping the value the merge strategy picks")
+	loadCmd.Flags().Lookup("dedupe-case").NoOptDefVal = string(client.KeyCaseUpper)
+	loadCmd.Flags().StringArrayVar(&loadRenames, "rename", []string{},
+		"Rename a key after merging, as OLD=NEW (e.g. PGHOST=DATABASE_HOST), may be repeated")
+	loadCmd.Flags().BoolVar(&loadResolveRefs, "resolve-refs", false,
+		"Replace provider:source#key values, such as vault:secret/data/db#password, with the referenced key")
+	loadCmd.Flags().BoolVar(&loadExpandOSEnv, "expand-os-env", false,
+		"Resolve ${env:NAME} references in values from the process environment")
+	loadCmd.Flags().BoolVar(&loadExpandBareOSEnv, "expand-bare-os-env", false,
+		"Also resolve bare $NAME references when --expand-os-env is set")
+	loadCmd.Flags().BoolVar(&loadTemplate, "template", false,
+		"Render values containing {{ }} as Go templates over the merged configuration (funcs: upper, lower, default, env)")
+	loadCmd.Flags().BoolVar(&loadStrictExpansion, "strict-expansion", false,
+		"Fail when a referenced variable is undefined instead of expanding it to an empty string")
+	loadCmd.Flags().StringSliceVar(&loadOnly, "only", []string{}, "Glob patterns of keys to keep (e.g. 'DB_*')")
+	loadCmd.Flags().StringSliceVar(&loadExclude, "exclude", []string{},
+		"Glob patterns of keys to drop, applied after --only")
+	loadCmd.Flags().BoolVar(&loadApplyDefaults, "apply-defaults", false,
+		"Set keys missing from all sources to the schema's default values (requires --validate)")
+	loadCmd.Flags().StringVar(&loadTransforms, "transforms", "",
+		"YAML or JSON file of per-key transform rules (keys glob and operation) applied to merged keys and values")
+	loadCmd.Flags().StringSliceVar(&loadRequire, "require", []string{},
+		"Keys that must be present after loading")
+	loadCmd.Flags().BoolVar(&loadPromptMissing, "prompt-missing", false,
+		"Prompt on the terminal, without echo, for required keys missing from every source (bounded by --timeout)")
+}
+
+// registerLoadValidationFlags defines the load flags validating the configuration.
+func registerLoadValidationFlags() {
+	loadCmd.Flags().StringArrayVar(&loadSchemas, "validate", []string{},
+		"JSON schema files for validation (repeatable; every schema must pass)")
+	loadCmd.Flags().BoolVar(&loadStrict, "strict", true,
+		"With --strict=false, report key and value limit violations as warnings instead of failing")
+	loadCmd.Flags().BoolVar(&loadRejectUnknownKeys, "fail-on-missing-schema-key", false,
+		"Reject keys not described by any --validate schema, even without additionalProperties: false")
+	loadCmd.Flags().StringVar(&loadAgainstExample, "against-example", "",
+		"Require every key listed in a .env.example file to be present (values are ignored)")
+	loadCmd.Flags().BoolVar(&loadWarnExtraKeys, "warn-extra-keys", false,
+		"Warn about keys not listed in the --against-example file")
+	loadCmd.Flags().StringVar(&loadKeyCase, "key-case", "",
+		"Require every key to follow a naming convention (upper_snake, lower_snake, kebab)")
+	loadCmd.Flags().BoolVar(&loadCheckEncoding, "check-encoding", false,
+		"Reject values with invalid UTF-8 or control characters other than tab and line breaks")
+	loadCmd.Flags().StringArrayVar(&loadRequireIf, "require-if", []string{},
+		"Require keys when another key has a value, as KEY=VALUE:REQUIRED[,REQUIRED...], may be repeated")
+	loadCmd.Flags().StringSliceVar(&loadAllowKeys, "allow-keys", []string{},
+		"Glob patterns of the only keys allowed in the configuration (e.g. APP_*)")
+	loadCmd.Flags().StringSliceVar(&loadDenyKeys, "deny-keys", []string{},
+		"Glob patterns of keys that must never appear in the configuration (e.g. AWS_SECRET_*)")
+	loadCmd.Flags().IntVar(&loadMaxKeys, "max-keys", 0,
+		fmt.Sprintf("Maximum number of configuration keys (default %d)", validator.MaxConfigKeys))
+	loadCmd.Flags().IntVar(&loadMaxKeyLength, "max-key-length", 0,
+		fmt.Sprintf("Maximum length of a configuration key (default %d)", validator.MaxKeyLength))
+	loadCmd.Flags().IntVar(&loadMaxValueSize, "max-value-size", 0,
+		fmt.Sprintf("Maximum length of a configuration value in bytes (default %d)", validator.MaxValueLength))
+}
+
+// registerLoadOutputFlags defines the load flags for exports and reports.
+func registerLoadOutputFlags() {
+	loadCmd.Flags().StringArrayVar(&loadExport, "export", []string{},
+		"Export format and destination (format:path, or a path with a known extension), may be repeated")
+	loadCmd.Flags().BoolVar(&loadFailFast, "fail-fast", false,
+		"Stop at the first failed export target instead of attempting the remaining ones")
+	loadCmd.Flags().StringVar(&loadOutputDir, "output-dir", ".", "Output directory for exported files")
+	loadCmd.Flags().BoolVar(&loadDryRun, "dry-run", false,
+		"Print the diff each --export target would apply to its file instead of writing files")
+	loadCmd.Flags().StringVar(&loadExportTemplate, "export-template", "",
+		"Go template rendered per key for the template export format (e.g. '{{.Key}}={{.Value | quote}}')")
+	loadCmd.Flags().StringVar(&loadExportTemplateHeader, "export-template-header", "",
+		"Optional Go template rendered once before all keys")
+	loadCmd.Flags().StringVar(&loadExportTemplateFooter, "export-template-footer", "",
+		"Optional Go template rendered once after all keys")
+	loadCmd.Flags().BoolVar(&loadNoMetadata, "no-metadata", false,
+		"Omit the metadata header (.env) and metadata object (JSON/YAML) from exported files")
+	loadCmd.Flags().StringVar(&loadExportPrefix, "export-prefix", "", "Prefix added to every exported key")
+	loadCmd.Flags().BoolVar(&loadGroup, "group", false,
+		"Group .env export by the first underscore-delimited key segment with a comment header per group")
+	loadCmd.Flags().StringSliceVar(&loadMaskKeys, "mask-keys", []string{},
+		"Additional glob patterns of keys whose values are masked in output (added to built-in defaults)")
+	loadCmd.Flags().BoolVar(&loadNoMask, "no-mask", false, "Disable masking of sensitive values in output")
+	loadCmd.Flags().BoolVar(&loadNest, "nest", false,
+		"Rebuild nested JSON/YAML exports by splitting keys on the flatten delimiter")
+	loadCmd.Flags().BoolVar(&loadAnnotate, "annotate-with-schema", false,
+		"Write schema descriptions and required markers as comments in .env exports (requires --validate)")
+	loadCmd.Flags().StringArrayVar(&loadArrayKeys, "array-key", []string{},
+		"Export KEY as a JSON/YAML array split on a delimiter, as KEY or KEY=DELIMITER (default ','), may be repeated")
+	loadCmd.Flags().BoolVar(&loadInferTypes, "infer-types", false,
+		"Write JSON/YAML export values that parse cleanly as numbers, booleans or null with those types")
+	loadCmd.Flags().StringVar(&loadK8sSecretName, "k8s-secret-name", exporter.DefaultK8sSecretName,
+		"metadata.name of k8s-secret exports")
+	loadCmd.Flags().StringVar(&loadK8sNamespace, "k8s-namespace", "",
+		"metadata.namespace of k8s-secret exports (omitted when empty)")
+	loadCmd.Flags().BoolVar(&loadMergeReport, "merge-report", false,
+		"Print every key set by more than one source with each source's (masked) value and the winner")
+	loadCmd.Flags().StringVar(&loadBaseline, "baseline", "",
+		"Report keys whose values rotated since the baseline snapshot file, then update it")
+	loadCmd.Flags().BoolVar(&loadBaselineAll, "baseline-all", false,
+		"Also report keys added or removed since the baseline")
+	loadCmd.Flags().BoolVar(&loadExplain, "explain", false,
+		"Print each key with the source that set its final value")
+	loadCmd.Flags().BoolVar(&loadShowResolved, "show-resolved", false,
+		"Print the merged, fully-resolved configuration (masked), marking keys changed by --resolve-refs, "+
+			"--expand-os-env or --template (${X} references inside a .env file are expanded on read, unmarked)")
+	loadCmd.Flags().StringVar(&loadOutput, "output", OutputFormatTable,
+		"Summary output format (table, json, yaml); progress goes to stderr for json and yaml")
+	loadCmd.Flags().BoolVar(&loadEncrypt, "encrypt", false, "Encrypt exported files with a passphrase")
 }
 
 // runLoadCommand executes the load command.
-func runLoadCommand(_ *cobra.Command, _ []string) error {
+func runLoadCommand(cmd *cobra.Command, _ []string) error {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
 	defer cancel()
 
+	// Resolve the active profile and the sources of its chain when a profiles config is given
+	profile, profileOrigin := resolveProfile(cmd.Flags().Changed("profile"), loadProfile, loadProfileEnvVar)
+	profileChain, err := applyProfilesConfig(profile)
+	if err != nil {
+		return err
+	}
+
 	// Validate inputs
 	if err := validateLoadInputs(); err != nil {
 		return err
 	}
 
-	// Create client
+	// Create client with providers, validators, transforms and exporter
+	envClient, err := setupLoadClient()
+	if err != nil {
+		return err
+	}
+
+	// Keep stdout clean when an export target or the summary writes to it. Requested
+	// reports go to output; progress messages go to status and are dropped by --quiet.
+	output := statusWriter(loadExport)
+	if loadOutput != OutputFormatTable {
+		output = os.Stderr
+	}
+	status := progress(output)
+
+	// Build value masker for output
+	masker, err := buildMasker(loadMaskKeys, loadNoMask)
+	if err != nil {
+		return err
+	}
+
+	// Report the active profile
+	if cliLogLevel == logLevelVerbose && profile != "" {
+		fmt.Fprintf(status, "Using profile %s (from %s)\n", profile, profileOrigin)
+		if len(profileChain) > 0 {
+			fmt.Fprintf(status, "Profile chain: %s\n", strings.Join(profileChain, " -> "))
+		}
+	}
+
+	// Build load options, prompting for missing required keys if requested
+	loadOptions, err := buildLoadOptions(profile)
+	if err != nil {
+		return err
+	}
+
+	// Check sources without loading values if requested
+	if loadCheck {
+		return runPreflight(ctx, envClient, loadOptions, output)
+	}
+
+	// Load configuration
+	fmt.Fprintf(status, "Loading configuration from %d sources...\n", len(loadSources))
+	env, err := envClient.Load(ctx, loadOptions)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Apply key selection
+	if len(loadOnly) > 0 || len(loadExclude) > 0 {
+		if err := env.Filter(loadOnly, loadExclude); err != nil {
+			return err
+		}
+	}
+
+	reportLoad(status, env, masker)
+	return writeLoadResults(ctx, output, status, envClient, env, masker)
+}
+
+// setupLoadClient creates the client for the load command with its providers, validators,
+// transform rules and, when exports are requested, exporter.
+func setupLoadClient() (*client.Client, error) {
 	envClient := client.New()
+	envClient.SetLogger(newConsoleLogger())
 
 	// Setup providers
-	setupProviders(envClient)
+	localProvider := setupProvidersWithOptions(envClient, local.Options{
+		AllowWorldWritable: loadAllowInsecurePerms,
+	})
+	passphrase, err := loadPassphrase()
+	if err != nil {
+		return nil, err
+	}
+	localProvider.SetDecryptionKey(passphrase)
+	localProvider.SetResolveFileRefs(loadResolveFileRefs)
+	localProvider.SetFlattenDelimiter(loadFlattenDelimiter)
+	if err := localProvider.SetStdinFormat(loadStdinFormat); err != nil {
+		return nil, err
+	}
+	if err := setupPluginProviders(envClient, loadPlugins); err != nil {
+		return nil, err
+	}
+	if err := applyProviderTimeouts(envClient, loadProviderTimes); err != nil {
+		return nil, err
+	}
 
-	// Setup validator if schema is provided
-	if loadSchema != "" {
-		if err := setupValidator(envClient); err != nil {
-			return fmt.Errorf("failed to setup validator: %w", err)
+	if err := setupLoadValidators(envClient); err != nil {
+		return nil, err
+	}
+
+	// Add transform rules
+	if loadTransforms != "" {
+		rules, err := client.LoadTransformRules(loadTransforms)
+		if err != nil {
+			return nil, err
+		}
+		if err := envClient.AddTransformRules(rules); err != nil {
+			return nil, err
 		}
 	}
 
 	// Setup exporter if export is requested
-	if loadExport != "" {
-		setupExporter(envClient)
+	if len(loadExport) > 0 {
+		if err := setupExporter(envClient, passphrase); err != nil {
+			return nil, fmt.Errorf("failed to setup exporter: %w", err)
+		}
+	}
+
+	return envClient, nil
+}
+
+// setupLoadValidators adds the validators requested by the load flags: custom rules when a
+// naming convention, encoding check or size limits are requested or in non-strict mode
+// (reporting violations as warnings), the schema validator if a schema is provided, and the
+// conditional, .env.example and key policy checks.
+func setupLoadValidators(envClient *client.Client) error {
+	rules, err := buildValidationRules(loadKeyCase, loadCheckEncoding)
+	if err != nil {
+		return err
+	}
+	limits := validator.Limits{
+		MaxKeys:        loadMaxKeys,
+		MaxKeyLength:   loadMaxKeyLength,
+		MaxValueLength: loadMaxValueSize,
+	}
+	if !loadStrict || len(rules) > 0 || limits != (validator.Limits{}) {
+		if err := setupCustomValidator(
+			envClient, loadSchemas, loadRejectUnknownKeys, !loadStrict, limits, rules...,
+		); err != nil {
+			return fmt.Errorf("failed to setup validator: %w", err)
+		}
+	} else if len(loadSchemas) > 0 {
+		if err := setupValidator(envClient, loadSchemas, loadRejectUnknownKeys); err != nil {
+			return fmt.Errorf("failed to setup validator: %w", err)
+		}
+	}
+
+	// Add conditional required keys
+	if err := addConditionalRules(envClient, loadRequireIf); err != nil {
+		return err
 	}
 
+	// Add .env.example contract check
+	if err := addExampleValidator(envClient, loadAgainstExample, loadWarnExtraKeys); err != nil {
+		return err
+	}
+
+	// Add key allowlist and denylist
+	return addKeyPolicyValidator(envClient, loadAllowKeys, loadDenyKeys)
+}
+
+// buildLoadOptions builds the client load options from the load flags for the active profile.
+func buildLoadOptions(profile string) (client.LoadOptions, error) {
 	// Parse merge strategy
 	mergeStrategy, err := parseMergeStrategy(loadMergeStrategy)
 	if err != nil {
-		return err
+		return client.LoadOptions{}, err
 	}
 
-	// Load configuration
-	fmt.Printf("Loading configuration from %d sources...\n", len(loadSources))
+	// Parse key case
+	keyCase, err := client.ParseKeyCase(loadNormalizeKeys)
+	if err != nil {
+		return client.LoadOptions{}, err
+	}
 
-	loadOptions := client.LoadOptions{
-		Sources:       loadSources,
-		Schema:        loadSchema,
-		MergeStrategy: mergeStrategy,
+	// Parse case deduplication
+	dedupeCase, err := client.ParseKeyCase(loadDedupeCase)
+	if err != nil {
+		return client.LoadOptions{}, err
+	}
+	if dedupeCase == client.KeyCaseSnake {
+		return client.LoadOptions{}, fmt.Errorf("unsupported dedupe case: %s (valid: upper, lower)", loadDedupeCase)
 	}
 
-	env, err := envClient.Load(ctx, loadOptions)
+	// Parse key renames
+	renames, err := parseKeyRenames(loadRenames)
 	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w", err)
+		return client.LoadOptions{}, err
 	}
 
-	// Display loaded configuration summary
-	fmt.Printf("Successfully loaded %d configuration keys\n", len(env.Data))
+	// Parse duplicate policy
+	duplicatePolicy, err := client.ParseDuplicatePolicy(loadOnDuplicate)
+	if err != nil {
+		return client.LoadOptions{}, err
+	}
 
-	// Export if requested
-	if loadExport != "" && !loadDryRun {
-		fmt.Printf("Exporting configuration to %s...\n", loadExport)
+	// A profiles config replaces the conventional <source>.<profile> layering
+	layerProfile := profile
+	if loadProfilesConfig != "" {
+		layerProfile = ""
+	}
 
-		if err := exportConfiguration(env, loadExport); err != nil {
-			return fmt.Errorf("failed to export configuration: %w", err)
+	loadOptions := client.LoadOptions{
+		Sources:         loadSources,
+		MergeStrategy:   mergeStrategy,
+		KeyCase:         keyCase,
+		DedupeCase:      dedupeCase,
+		KeyRenames:      renames,
+		ExpandOSEnv:     loadExpandOSEnv,
+		ExpandBareOSEnv: loadExpandBareOSEnv,
+		StrictExpansion: loadStrictExpansion,
+		Template:        loadTemplate,
+		Profile:         layerProfile,
+		MaxKeys:         loadMaxKeys,
+
+		OnDuplicateInSource: duplicatePolicy,
+		RequiredKeys:        loadRequire,
+		ResolveReferences:   loadResolveRefs,
+	}
+
+	// Prompt for missing required keys if requested
+	if loadPromptMissing {
+		if loadOptions.RequiredKeys, err = promptRequiredKeys(loadRequire, loadSchemas); err != nil {
+			return client.LoadOptions{}, err
+		}
+		loadOptions.PromptMissing = promptSecret
+	}
+
+	// Read schema defaults if requested
+	if loadApplyDefaults {
+		if loadOptions.Defaults, err = schemaDefaults(loadSchemas); err != nil {
+			return client.LoadOptions{}, err
 		}
+	}
+
+	return loadOptions, nil
+}
+
+// reportLoad prints the summary of a completed load to status and adds the keys loaded
+// through secret references to the masker.
+func reportLoad(status io.Writer, env *client.Environment, masker *client.Masker) {
+	fmt.Fprintf(status, "Successfully loaded %d configuration keys\n", len(env.Data))
+	if cliLogLevel == logLevelVerbose {
+		printSourceDetails(status, env.Sources)
+	}
+	if len(env.DefaultedKeys) > 0 {
+		fmt.Fprintf(status, "Applied schema defaults for %s\n", strings.Join(env.DefaultedKeys, ", "))
+	}
+	if len(env.ReferencedKeys) > 0 {
+		masker.AddKeys(env.ReferencedKeys...)
+		fmt.Fprintf(status, "Resolved secret references for %s\n", strings.Join(env.ReferencedKeys, ", "))
+	}
+	if len(env.PromptedKeys) > 0 {
+		fmt.Fprintf(status, "Using entered values for %s\n", strings.Join(env.PromptedKeys, ", "))
+	}
+}
 
-		fmt.Println("Configuration exported successfully")
+// writeLoadResults exports the loaded configuration, or prints the dry-run diffs, and writes
+// the requested reports, baseline check and summary.
+func writeLoadResults(
+	ctx context.Context, output, status io.Writer, envClient *client.Client, env *client.Environment,
+	masker *client.Masker,
+) error {
+	// Export if requested
+	if len(loadExport) > 0 && !loadDryRun {
+		if err := exportConfiguration(ctx, env, loadExport, loadFailFast, status); err != nil {
+			return fmt.Errorf("failed to export configuration: %w", err)
+		}
 	}
 
 	// Display dry run information
 	if loadDryRun {
-		fmt.Println("\nDry run completed - no files were written")
-		fmt.Printf("Configuration keys: %v\n", env.Keys())
+		if len(loadExport) > 0 {
+			if err := printDryRunDiffs(output, envClient, env, loadExport, masker); err != nil {
+				return fmt.Errorf("failed to render exports: %w", err)
+			}
+		}
+		fmt.Fprintln(status, "\nDry run completed - no files were written")
+	}
+
+	// Display configuration
+	switch {
+	case loadExplain:
+		printExplanation(output, env, masker)
+	case loadShowResolved:
+		printResolvedConfiguration(output, env, masker)
+	case loadDryRun:
+		printConfiguration(output, env.Data, masker)
+	}
+	if loadMergeReport {
+		printMergeReport(output, env.MergeReport, masker)
+	}
+
+	// Detect rotated values against the baseline
+	if loadBaseline != "" {
+		if err := checkBaseline(output, status, env); err != nil {
+			return err
+		}
+	}
+
+	// Print machine-readable summary
+	if loadOutput != OutputFormatTable {
+		return writeStructured(os.Stdout, buildLoadSummary(env, envClient.HasValidator()), loadOutput)
 	}
 
 	return nil
 }
 
+// buildLoadSummary builds the machine-readable summary of a completed load.
+func buildLoadSummary(env *client.Environment, validated bool) loadSummary {
+	summary := loadSummary{
+		Sources:   make([]loadSourceSummary, 0, len(env.Sources)),
+		TotalKeys: env.Size(),
+		Validated: validated,
+		Warnings:  env.Warnings,
+		DryRun:    loadDryRun,
+	}
+
+	for _, source := range env.Sources {
+		summary.Sources = append(summary.Sources, loadSourceSummary{
+			Name:     source.Name,
+			Provider: source.Provider,
+			Keys:     source.KeyCount,
+		})
+	}
+
+	if !loadDryRun {
+		summary.Exports = loadExport
+	}
+
+	return summary
+}
+
+// expandSourceGlobs expands glob patterns in local sources into sorted file paths.
+// Sources of other providers are passed through untouched, and a pattern matching
+// no files is an error. A path starting with a volume name, such as C:\configs\*.env
+// on Windows, is a local source rather than one of provider "C".
+func expandSourceGlobs(sources []string) ([]string, error) {
+	expanded := make([]string, 0, len(sources))
+
+	for _, source := range sources {
+		prefix, pattern := "", source
+		if strings.HasPrefix(source, local.ProviderName+":") {
+			prefix, pattern = local.ProviderName+":", strings.TrimPrefix(source, local.ProviderName+":")
+		} else if filepath.VolumeName(source) == "" && strings.Contains(source, ":") {
+			expanded = append(expanded, source)
+			continue
+		}
+
+		if !strings.ContainsAny(pattern, "*?[") {
+			expanded = append(expanded, source)
+			continue
+		}
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source pattern %s: %w", source, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match source pattern %s", source)
+		}
+
+		sort.Strings(matches)
+		for _, match := range matches {
+			expanded = append(expanded, prefix+match)
+		}
+	}
+
+	return expanded, nil
+}
+
 // validateLoadInputs validates the load command inputs.
 func validateLoadInputs() error {
 	// Check number of sources
 	if len(loadSources) == 0 {
-		return fmt.Errorf("at least one source must be specified")
+		return fmt.Errorf("at least one source must be specified with --from or --profiles-config")
+	}
+
+	// Expand glob patterns in local sources
+	expanded, err := expandSourceGlobs(loadSources)
+	if err != nil {
+		return err
 	}
+	loadSources = expanded
 
 	if len(loadSources) > MaxSources {
 		return fmt.Errorf("too many sources: %d > %d", len(loadSources), MaxSources)
 	}
 
+	// Validate size limits
+	if loadMaxKeys < 0 || loadMaxKeyLength < 0 || loadMaxValueSize < 0 {
+		return fmt.Errorf("--max-keys, --max-key-length and --max-value-size must not be negative")
+	}
+
 	// Validate merge strategy
-	validStrategies := []string{"override", "preserve", "error"}
+	validStrategies := []string{"override", "preserve", "error", "conflict", "priority"}
 	valid := false
 	for _, strategy := range validStrategies {
 		if loadMergeStrategy == strategy {
@@ -180,31 +750,304 @@ func validateLoadInputs() error {
 		return fmt.Errorf("invalid merge strategy: %s (valid: %v)", loadMergeStrategy, validStrategies)
 	}
 
-	// Validate schema file if provided
-	if loadSchema != "" {
-		if _, err := os.Stat(loadSchema); os.IsNotExist(err) {
-			return fmt.Errorf("schema file not found: %s", loadSchema)
+	// Validate encryption flags
+	if loadEncrypt && len(loadExport) == 0 {
+		return fmt.Errorf("--encrypt requires --export")
+	}
+	if loadEncryptKey != "" && loadEncryptKeyFile != "" {
+		return fmt.Errorf("--encrypt-key and --encrypt-key-file cannot be used together")
+	}
+
+	// Validate annotation flags
+	if loadAnnotate && len(loadSchemas) == 0 {
+		return fmt.Errorf("--annotate-with-schema requires --validate")
+	}
+
+	// Validate defaults flags
+	if loadApplyDefaults && len(loadSchemas) == 0 {
+		return fmt.Errorf("--apply-defaults requires --validate")
+	}
+
+	// Validate example flags
+	if loadWarnExtraKeys && loadAgainstExample == "" {
+		return fmt.Errorf("--warn-extra-keys requires --against-example")
+	}
+
+	// Validate output format
+	if loadOutput != OutputFormatTable && loadOutput != OutputFormatJSON && loadOutput != OutputFormatYAML {
+		return fmt.Errorf("unsupported output format: %s (valid: table, json, yaml)", loadOutput)
+	}
+
+	// Validate schema flags
+	if loadRejectUnknownKeys && len(loadSchemas) == 0 {
+		return fmt.Errorf("--fail-on-missing-schema-key requires --validate")
+	}
+
+	// Validate template flags
+	if loadExportTemplate == "" && (loadExportTemplateHeader != "" || loadExportTemplateFooter != "") {
+		return fmt.Errorf("--export-template-header and --export-template-footer require --export-template")
+	}
+
+	// Validate schema files if provided
+	for _, schemaPath := range loadSchemas {
+		if _, err := os.Stat(schemaPath); os.IsNotExist(err) {
+			return fmt.Errorf("schema file not found: %s", schemaPath)
 		}
 	}
 
 	return nil
 }
 
-// setupProviders configures the providers for the client.
-func setupProviders(envClient *client.Client) {
+// statusWriter returns where progress messages are written: stderr when any export
+// target writes to stdout, so the exported content can be piped, and stdout otherwise.
+func statusWriter(targets []string) io.Writer {
+	for _, target := range targets {
+		if strings.HasSuffix(target, ":"+exporter.StdoutPath) {
+			return os.Stderr
+		}
+	}
+	return os.Stdout
+}
+
+// resolveProfile returns the active profile and where it came from: the --profile flag when
+// set (an empty value disables profiles), then the named environment variable, then none.
+func resolveProfile(flagSet bool, flagValue, envVar string) (profile, origin string) {
+	if flagSet {
+		return flagValue, "--profile"
+	}
+
+	if envVar != "" {
+		if value := strings.TrimSpace(os.Getenv(envVar)); value != "" {
+			return value, "$" + envVar
+		}
+	}
+
+	return "", ""
+}
+
+// applyProfilesConfig prepends the sources of the profile's chain from --profiles-config to
+// the --from sources and returns the chain, root profile first. Every source then merges with
+// override semantics, so the profile's own sources win over its parents' and --from wins over all.
+func applyProfilesConfig(profile string) ([]string, error) {
+	if loadProfilesConfig == "" {
+		return nil, nil
+	}
+	if profile == "" {
+		return nil, fmt.Errorf("--profiles-config requires --profile or $%s", loadProfileEnvVar)
+	}
+	if loadMergeStrategy != DefaultMergeStrategy {
+		return nil, fmt.Errorf("--profiles-config loads sources with override semantics and cannot be "+
+			"combined with --merge-strategy=%s", loadMergeStrategy)
+	}
+
+	config, err := client.LoadProfileConfig(loadProfilesConfig)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := config.Chain(profile)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := config.Sources(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	loadSources = append(sources, loadSources...)
+	return chain, nil
+}
+
+// printSourceDetails prints the provider and key count of every loaded source.
+func printSourceDetails(w io.Writer, sources []client.SourceInfo) {
+	for _, source := range sources {
+		fmt.Fprintf(w, "  %s (provider %s): %d keys\n", source.Name, source.Provider, source.KeyCount)
+	}
+}
+
+// buildMasker creates the masker used for value output.
+// Returns nil (no masking) when masking is disabled.
+func buildMasker(patterns []string, disabled bool) (*client.Masker, error) {
+	if disabled {
+		return nil, nil
+	}
+
+	return client.NewDefaultMasker(patterns...)
+}
+
+// printConfiguration prints configuration keys and masked values in sorted order.
+func printConfiguration(w io.Writer, config map[string]string, masker *client.Masker) {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(w, "Configuration (%d keys):\n", len(keys))
+	for _, key := range keys {
+		fmt.Fprintf(w, "  %s=%s\n", key, masker.Mask(key, config[key]))
+	}
+}
+
+// printExplanation prints each key with the source that set its final value.
+func printExplanation(w io.Writer, env *client.Environment, masker *client.Masker) {
+	keys := env.Keys()
+	sort.Strings(keys)
+
+	fmt.Fprintf(w, "Configuration provenance (%d keys):\n", len(keys))
+	for _, key := range keys {
+		source, ok := env.Provenance(key)
+		if !ok {
+			source = "unknown"
+		}
+		fmt.Fprintf(w, "  %s=%s  <- %s\n", key, masker.Mask(key, env.Data[key]), source)
+	}
+}
+
+// printMergeReport prints the keys set by more than one source with masked values, marking
+// the winning source of each.
+func printMergeReport(w io.Writer, report client.MergeReport, masker *client.Masker) {
+	fmt.Fprintf(w, "Merge report (strategy %s, %d keys set by more than one source):\n",
+		report.Strategy, len(report.Conflicts))
+
+	for _, conflict := range report.Conflicts {
+		note := ""
+		if conflict.Identical() {
+			note = "  (identical values)"
+		}
+		fmt.Fprintf(w, "  %s <- %s%s\n", conflict.Key, conflict.Winner, note)
+
+		for _, contribution := range conflict.Contributions {
+			fmt.Fprintf(w, "      %s=%s\n", contribution.Source, masker.Mask(conflict.Key, contribution.Value))
+		}
+	}
+}
+
+// printResolvedConfiguration prints the resolved configuration in sorted order with masked
+// values, marking the keys listed in env.ResolvedKeys.
+func printResolvedConfiguration(w io.Writer, env *client.Environment, masker *client.Masker) {
+	// Only count resolved keys that survived key selection
+	resolved := make(map[string]bool, len(env.ResolvedKeys))
+	for _, key := range env.ResolvedKeys {
+		if _, exists := env.Data[key]; exists {
+			resolved[key] = true
+		}
+	}
+
+	keys := env.Keys()
+	sort.Strings(keys)
+
+	fmt.Fprintf(w, "Resolved configuration (%d keys, %d resolved):\n", len(keys), len(resolved))
+	for _, key := range keys {
+		marker := ""
+		if resolved[key] {
+			marker = "  (resolved)"
+		}
+		fmt.Fprintf(w, "  %s=%s%s\n", key, masker.Mask(key, env.Data[key]), marker)
+	}
+}
+
+// setupProviders configures the providers for the client and returns the local provider.
+func setupProviders(envClient *client.Client) *local.Provider {
+	return setupProvidersWithOptions(envClient, local.Options{})
+}
+
+// setupProvidersWithOptions configures the providers for the client with custom local
+// provider options and returns the local provider.
+func setupProvidersWithOptions(envClient *client.Client, options local.Options) *local.Provider {
 	// Setup local provider
-	localProvider := local.NewProviderWithBase(".")
-	envClient.AddProvider("local", localProvider)
+	localProvider := local.NewProviderWithOptions(".", options)
+	localProvider.SetLogger(newConsoleLogger())
+	addRegisteredProvider(envClient, local.ProviderName, localProvider)
 
 	// Also add as default provider
 	envClient.AddProvider(client.DefaultProviderName, localProvider)
+	if info, err := registry.GetProvider(local.ProviderName); err == nil {
+		envClient.SetProviderPriority(client.DefaultProviderName, info.Priority)
+	}
+
+	// Setup SOPS provider for encrypted files
+	addRegisteredProvider(envClient, sops.ProviderName, sops.NewProviderWithBase("."))
+
+	// Setup archive provider for bundles of configuration files
+	addRegisteredProvider(envClient, archive.ProviderName, archive.NewProviderWithBase("."))
+
+	// Setup docker secrets provider for secrets mounted as files
+	addRegisteredProvider(envClient, dockersecrets.ProviderName, dockersecrets.NewProvider())
 
 	// TODO: Add other providers (K8s, Vault, S3) in future phases
+	return localProvider
+}
+
+// addRegisteredProvider adds a provider to the client under its name and the aliases it is
+// registered with, such as zip: for the archive provider, using the registry priority for
+// priority-ordered merges.
+func addRegisteredProvider(envClient *client.Client, name string, provider client.Provider) {
+	info, err := registry.GetProvider(name)
+	if err != nil {
+		envClient.AddProvider(name, provider)
+		return
+	}
+
+	for _, providerName := range append([]string{name}, info.Aliases...) {
+		envClient.AddProvider(providerName, provider)
+		envClient.SetProviderPriority(providerName, info.Priority)
+	}
+}
+
+// newSchemaValidator creates a validator for one or more schemas, optionally rejecting keys
+// not in any schema. Several schemas must all pass and their failures name the schema file.
+func newSchemaValidator(schemaPaths []string, rejectUnknown bool) (validator.Validator, error) {
+	if len(schemaPaths) > 1 {
+		return validator.NewMultiSchemaValidator(schemaPaths, rejectUnknown)
+	}
+	if rejectUnknown {
+		return validator.NewSchemaValidatorStrict(schemaPaths[0])
+	}
+	return validator.NewSchemaValidator(schemaPaths[0])
+}
+
+// schemaDefaults reads the defaults declared by the schemas. When several schemas declare a
+// default for the same key, the first schema wins.
+func schemaDefaults(schemaPaths []string) (map[string]string, error) {
+	defaults := make(map[string]string)
+	for _, schemaPath := range schemaPaths {
+		schemaDefaults, err := validator.SchemaDefaults(schemaPath)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range schemaDefaults {
+			if _, exists := defaults[key]; !exists {
+				defaults[key] = value
+			}
+		}
+	}
+	return defaults, nil
+}
+
+// schemaAnnotations reads the key annotations of the schemas. A key is required when any
+// schema requires it and uses the first description found.
+func schemaAnnotations(schemaPaths []string) (map[string]exporter.KeyAnnotation, error) {
+	annotations := make(map[string]exporter.KeyAnnotation)
+	for _, schemaPath := range schemaPaths {
+		schemaAnnotations, err := exporter.LoadSchemaAnnotations(schemaPath)
+		if err != nil {
+			return nil, err
+		}
+		for key, annotation := range schemaAnnotations {
+			merged := annotations[key]
+			if merged.Description == "" {
+				merged.Description = annotation.Description
+			}
+			merged.Required = merged.Required || annotation.Required
+			annotations[key] = merged
+		}
+	}
+	return annotations, nil
 }
 
 // setupValidator configures the validator for the client.
-func setupValidator(envClient *client.Client) error {
-	schemaValidator, err := validator.NewSchemaValidator(loadSchema)
+func setupValidator(envClient *client.Client, schemaPaths []string, rejectUnknown bool) error {
+	schemaValidator, err := newSchemaValidator(schemaPaths, rejectUnknown)
 	if err != nil {
 		return err
 	}
@@ -213,10 +1056,326 @@ func setupValidator(envClient *client.Client) error {
 	return nil
 }
 
-// setupExporter configures the exporter for the client.
-func setupExporter(envClient *client.Client) {
-	multiExporter := exporter.NewMultiFormatExporter(loadOutputDir)
+// applyProviderTimeouts parses name=duration pairs and sets the per-provider load timeouts.
+func applyProviderTimeouts(envClient *client.Client, specs []string) error {
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", KeyValueParts)
+		if len(parts) != KeyValueParts || parts[0] == "" {
+			return fmt.Errorf("invalid provider timeout %q, expected name=duration", spec)
+		}
+
+		timeout, err := time.ParseDuration(parts[1])
+		if err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid provider timeout %q: duration must be positive (e.g. 90s)", spec)
+		}
+
+		envClient.SetProviderTimeout(parts[0], timeout)
+	}
+
+	return nil
+}
+
+// setupPluginProviders parses name=binary pairs and adds a plugin provider for each.
+func setupPluginProviders(envClient *client.Client, specs []string) error {
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", KeyValueParts)
+		if len(parts) != KeyValueParts || parts[0] == "" {
+			return fmt.Errorf("invalid plugin %q, expected name=binary", spec)
+		}
+
+		provider, err := plugin.NewProvider(parts[0], parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid plugin %q: %w", spec, err)
+		}
+		envClient.AddProvider(parts[0], provider)
+	}
+
+	return nil
+}
+
+// runPreflight checks every source without loading values and prints a per-source report.
+func runPreflight(ctx context.Context, envClient *client.Client, options client.LoadOptions, w io.Writer) error {
+	report := envClient.Preflight(ctx, options)
+
+	for _, result := range report.Results {
+		switch {
+		case result.Err != nil:
+			fmt.Fprintf(w, "  FAIL %s: %v\n", result.Source, result.Err)
+		case result.Skipped:
+			fmt.Fprintf(w, "  SKIP %s (optional, not found)\n", result.Source)
+		default:
+			fmt.Fprintf(w, "  OK   %s\n", result.Source)
+		}
+	}
+
+	if err := report.Err(); err != nil {
+		return fmt.Errorf("preflight check failed: %w", err)
+	}
+
+	fmt.Fprintf(w, "All %d sources are resolvable\n", len(report.Results))
+	return nil
+}
+
+// buildValidationRules creates the custom validation rules requested by flags.
+func buildValidationRules(keyCase string, checkEncoding bool) ([]validator.ValidationRule, error) {
+	var rules []validator.ValidationRule
+
+	if checkEncoding {
+		rules = append(rules, validator.NewEncodingRule(true))
+	}
+
+	if keyCase != "" {
+		namingRule, err := validator.NewNamingRule(keyCase)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, namingRule)
+	}
+
+	return rules, nil
+}
+
+// setupCustomValidator configures a custom validator with the given rules, combined with
+// the schema validator when schemas are provided. In lenient mode violations are warnings.
+// Zero limits keep the validator defaults.
+func setupCustomValidator(
+	envClient *client.Client,
+	schemaPaths []string,
+	rejectUnknown, lenient bool,
+	limits validator.Limits,
+	rules ...validator.ValidationRule,
+) error {
+	customValidator := validator.NewCustomValidatorWithLimits(limits, rules...)
+	if lenient {
+		customValidator = validator.NewCustomValidatorLenient(rules...)
+		customValidator.SetLimits(limits)
+	}
+
+	if len(schemaPaths) == 0 {
+		envClient.SetValidator(customValidator)
+		return nil
+	}
+
+	schemaValidator, err := newSchemaValidator(schemaPaths, rejectUnknown)
+	if err != nil {
+		return err
+	}
+
+	envClient.SetValidator(validator.NewCompositeValidator(schemaValidator, customValidator))
+	return nil
+}
+
+// addConditionalRules parses KEY=VALUE:REQUIRED[,REQUIRED...] specifications and adds a
+// conditional rule for each to the client's validators.
+func addConditionalRules(envClient *client.Client, specs []string) error {
+	if len(specs) == 0 {
+		return nil
+	}
+
+	rules, err := parseConditionalRules(specs)
+	if err != nil {
+		return err
+	}
+
+	var validators []validator.Validator
+	if current := envClient.Validator(); current != nil {
+		validators = append(validators, current)
+	}
+	for _, rule := range rules {
+		validators = append(validators, rule)
+	}
+
+	envClient.SetValidator(validator.NewCompositeValidator(validators...))
+	return nil
+}
+
+// parseConditionalRules parses KEY=VALUE:REQUIRED[,REQUIRED...] specifications into conditional rules.
+func parseConditionalRules(specs []string) ([]*validator.ConditionalRule, error) {
+	rules := make([]*validator.ConditionalRule, 0, len(specs))
+	for _, spec := range specs {
+		// Split at the last colon, so the value may itself contain colons
+		separator := strings.LastIndex(spec, ":")
+		if separator == -1 {
+			return nil, fmt.Errorf("invalid --require-if %q, expected KEY=VALUE:REQUIRED[,REQUIRED...]", spec)
+		}
+		required := spec[separator+1:]
+		key, value, hasValue := strings.Cut(spec[:separator], "=")
+		if !hasValue || key == "" || strings.TrimSpace(required) == "" {
+			return nil, fmt.Errorf("invalid --require-if %q, expected KEY=VALUE:REQUIRED[,REQUIRED...]", spec)
+		}
+
+		// Split and trim required keys
+		var keys []string
+		for _, requiredKey := range strings.Split(required, ",") {
+			if requiredKey = strings.TrimSpace(requiredKey); requiredKey != "" {
+				keys = append(keys, requiredKey)
+			}
+		}
+		rules = append(rules, validator.NewConditionalRule(key, value, keys...))
+	}
+
+	return rules, nil
+}
+
+// addExampleValidator adds a check that every key of the example file is loaded,
+// composed with the validators already configured.
+func addExampleValidator(envClient *client.Client, examplePath string, warnExtra bool) error {
+	if examplePath == "" {
+		return nil
+	}
+
+	example, err := validator.NewExampleValidator(examplePath)
+	if err != nil {
+		return err
+	}
+	example.SetWarnExtraKeys(warnExtra)
+
+	validators := []validator.Validator{example}
+	if current := envClient.Validator(); current != nil {
+		validators = append([]validator.Validator{current}, validators...)
+	}
+
+	envClient.SetValidator(validator.NewCompositeValidator(validators...))
+	return nil
+}
+
+// addKeyPolicyValidator adds the key allowlist and denylist, composed with the validators
+// already configured.
+func addKeyPolicyValidator(envClient *client.Client, allow, deny []string) error {
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil
+	}
+
+	policy, err := validator.NewKeyPolicyValidator(allow, deny)
+	if err != nil {
+		return err
+	}
+
+	validators := []validator.Validator{policy}
+	if current := envClient.Validator(); current != nil {
+		validators = append([]validator.Validator{current}, validators...)
+	}
+
+	envClient.SetValidator(validator.NewCompositeValidator(validators...))
+	return nil
+}
+
+// nestDelimiter returns the delimiter for nested JSON/YAML output, or "" when nesting is disabled.
+func nestDelimiter(nest bool, delimiter string) string {
+	if !nest {
+		return ""
+	}
+	if delimiter == "" {
+		return local.FlattenDelimiter
+	}
+	return delimiter
+}
+
+// parseArrayKeys parses KEY or KEY=DELIMITER specifications into exporter array keys.
+func parseArrayKeys(specs []string) (map[string]string, error) {
+	arrayKeys := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", KeyValueParts)
+		if parts[0] == "" {
+			return nil, fmt.Errorf("invalid array key %q, expected KEY or KEY=DELIMITER", spec)
+		}
+
+		delimiter := DefaultArrayDelimiter
+		if len(parts) == KeyValueParts {
+			if parts[1] == "" {
+				return nil, fmt.Errorf("invalid array key %q: delimiter cannot be empty", spec)
+			}
+			delimiter = parts[1]
+		}
+		arrayKeys[parts[0]] = delimiter
+	}
+	return arrayKeys, nil
+}
+
+// parseKeyRenames parses OLD=NEW key renames.
+func parseKeyRenames(specs []string) (map[string]string, error) {
+	renames := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, "=", KeyValueParts)
+		if len(parts) != KeyValueParts || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid rename %q, expected OLD=NEW", spec)
+		}
+		if _, exists := renames[parts[0]]; exists {
+			return nil, fmt.Errorf("invalid rename %q: %s is already renamed", spec, parts[0])
+		}
+		renames[parts[0]] = parts[1]
+	}
+	return renames, nil
+}
+
+// loadPassphrase returns the passphrase given with --encrypt-key-file or --encrypt-key. When
+// neither is set it is empty, and encryption falls back to $ENVSYNC_ENCRYPTION_KEY.
+func loadPassphrase() (string, error) {
+	if loadEncryptKeyFile != "" {
+		return encryption.ReadPassphraseFile(loadEncryptKeyFile)
+	}
+
+	if loadEncryptKey != "" {
+		newConsoleLogger().Warnf("--encrypt-key exposes the passphrase in process listings and shell history; "+
+			"prefer --encrypt-key-file or $%s", encryption.KeyEnvVar)
+	}
+	return loadEncryptKey, nil
+}
+
+// setupExporter configures the exporter for the client, encrypting exports with the
+// passphrase, or $ENVSYNC_ENCRYPTION_KEY when it is empty, if --encrypt is set.
+func setupExporter(envClient *client.Client, passphrase string) error {
+	arrayKeys, err := parseArrayKeys(loadArrayKeys)
+	if err != nil {
+		return err
+	}
+
+	multiExporter := exporter.NewMultiFormatExporterWithOptions(loadOutputDir, exporter.Options{
+		NoMetadata:    loadNoMetadata,
+		KeyPrefix:     loadExportPrefix,
+		GroupByPrefix: loadGroup,
+		NestDelimiter: nestDelimiter(loadNest, loadFlattenDelimiter),
+		ArrayKeys:     arrayKeys,
+		InferTypes:    loadInferTypes,
+		K8sSecretName: loadK8sSecretName,
+		K8sNamespace:  loadK8sNamespace,
+	})
+
+	// Annotate .env output with schema descriptions if requested
+	if loadAnnotate {
+		annotations, err := schemaAnnotations(loadSchemas)
+		if err != nil {
+			return err
+		}
+		multiExporter.SetAnnotations(annotations)
+	}
+
+	// Configure template export if requested
+	if loadExportTemplate != "" {
+		if err := multiExporter.SetTemplate(
+			loadExportTemplate, loadExportTemplateHeader, loadExportTemplateFooter); err != nil {
+			return err
+		}
+	}
+
+	// Wrap exporter with encryption if requested
+	if loadEncrypt {
+		passphrase, err := encryption.ResolvePassphrase(passphrase)
+		if err != nil {
+			return err
+		}
+
+		encryptedExporter, err := exporter.NewEncryptedExporter(multiExporter, passphrase)
+		if err != nil {
+			return err
+		}
+
+		envClient.SetExporter(encryptedExporter)
+		return nil
+	}
+
 	envClient.SetExporter(multiExporter)
+	return nil
 }
 
 // parseMergeStrategy converts string merge strategy to client enum.
@@ -228,24 +1387,48 @@ func parseMergeStrategy(strategy string) (client.MergeStrategy, error) {
 		return client.MergeStrategyPreserve, nil
 	case "error":
 		return client.MergeStrategyError, nil
+	case "conflict":
+		return client.MergeStrategyErrorOnConflict, nil
+	case "priority":
+		return client.MergeStrategyPriority, nil
 	default:
 		return client.MergeStrategyOverride, fmt.Errorf("unknown merge strategy: %s", strategy)
 	}
 }
 
-// exportConfiguration exports the loaded configuration.
-func exportConfiguration(env *client.Environment, exportSpec string) error {
-	// Use the environment's built-in export methods based on format
-	if exportSpec == "" {
-		return fmt.Errorf("export specification cannot be empty")
-	}
+// exportConfiguration exports the loaded configuration to every target.
+// A failed target is reported and the remaining targets are still attempted
+// unless failFast is set; all failures are returned together.
+func exportConfiguration(
+	ctx context.Context, env *client.Environment, targets []string, failFast bool, status io.Writer,
+) error {
+	var failures []error
+
+	for _, target := range targets {
+		if target == "" {
+			return fmt.Errorf("export specification cannot be empty")
+		}
 
-	// Parse export format
-	parts := []string{exportSpec}
-	if len(parts) > 0 && parts[0] != "" {
-		// Let the client handle the export through its configured exporter
-		return env.ExportEnv(exportSpec) // This will be handled by the exporter based on format
+		fmt.Fprintf(status, "Exporting configuration to %s...\n", target)
+
+		written, err := env.ExportChanged(ctx, target)
+		if err != nil {
+			failure := fmt.Errorf("target %s: %w", target, err)
+			if failFast {
+				return failure
+			}
+
+			fmt.Fprintf(os.Stderr, "Export to %s failed: %v\n", target, err)
+			failures = append(failures, failure)
+			continue
+		}
+
+		if !written {
+			fmt.Fprintf(status, "Configuration unchanged in %s, skipped writing\n", target)
+			continue
+		}
+		fmt.Fprintf(status, "Configuration exported successfully to %s\n", target)
 	}
 
-	return fmt.Errorf("invalid export specification: %s", exportSpec)
+	return errors.Join(failures...)
 }
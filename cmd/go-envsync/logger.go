@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// logLevel controls how much non-error output the CLI prints.
+type logLevel int
+
+// Log levels selected by the --quiet and --verbose flags
+const (
+	// logLevelQuiet suppresses all non-error output.
+	logLevelQuiet logLevel = iota
+
+	// logLevelNormal prints progress messages and warnings.
+	logLevelNormal
+
+	// logLevelVerbose additionally prints per-source details and debug messages.
+	logLevelVerbose
+)
+
+// Global logging flags
+var (
+	quietOutput   bool
+	verboseOutput bool
+	cliLogLevel   = logLevelNormal
+)
+
+// setLogLevel sets the CLI log level from the --quiet and --verbose flags.
+func setLogLevel(quiet, verbose bool) error {
+	switch {
+	case quiet && verbose:
+		return fmt.Errorf("--quiet and --verbose cannot be used together")
+	case quiet:
+		cliLogLevel = logLevelQuiet
+	case verbose:
+		cliLogLevel = logLevelVerbose
+	default:
+		cliLogLevel = logLevelNormal
+	}
+	return nil
+}
+
+// progress returns w, or a writer discarding everything when --quiet is set.
+func progress(w io.Writer) io.Writer {
+	if cliLogLevel == logLevelQuiet {
+		return io.Discard
+	}
+	return w
+}
+
+// consoleLogger implements client.Logger by writing to the terminal.
+// Messages go to stderr so they never mix with exports written to stdout.
+type consoleLogger struct {
+	out   io.Writer
+	level logLevel
+}
+
+// newConsoleLogger creates a console logger writing to stderr at the CLI log level.
+func newConsoleLogger() *consoleLogger {
+	return &consoleLogger{
+		out:   os.Stderr,
+		level: cliLogLevel,
+	}
+}
+
+// Debugf logs a debug message when verbose output is enabled.
+func (l *consoleLogger) Debugf(format string, args ...interface{}) {
+	if l.level >= logLevelVerbose {
+		l.printf("Debug: ", format, args...)
+	}
+}
+
+// Infof logs an informational message unless output is quiet.
+func (l *consoleLogger) Infof(format string, args ...interface{}) {
+	if l.level >= logLevelNormal {
+		l.printf("", format, args...)
+	}
+}
+
+// Warnf logs a warning message unless output is quiet.
+func (l *consoleLogger) Warnf(format string, args ...interface{}) {
+	if l.level >= logLevelNormal {
+		l.printf("Warning: ", format, args...)
+	}
+}
+
+// printf writes a prefixed message line.
+func (l *consoleLogger) printf(prefix, format string, args ...interface{}) {
+	fmt.Fprintf(l.out, prefix+format+"\n", args...)
+}
@@ -54,10 +54,24 @@ var (
 func init() {
 	// Add version flag to root command
 	rootCmd.Flags().BoolVarP(&showVersion, "version", "v", false, "Show version information")
+
+	// Add logging flags to all commands
+	rootCmd.PersistentFlags().BoolVarP(&quietOutput, "quiet", "q", false,
+		"Suppress all non-error output such as progress messages and warnings")
+	rootCmd.PersistentFlags().BoolVar(&verboseOutput, "verbose", false,
+		"Print per-source details and debug messages")
 }
 
 // initializeApplication performs application-wide initialization.
-func initializeApplication(_ *cobra.Command, _ []string) error {
+func initializeApplication(cmd *cobra.Command, _ []string) error {
+	// Set log level, keeping usage text out of quiet error output
+	if err := setLogLevel(quietOutput, verboseOutput); err != nil {
+		return err
+	}
+	if cliLogLevel == logLevelQuiet {
+		cmd.SilenceUsage = true
+	}
+
 	// Initialize providers registry
 	if err := providers.InitializeProviders(); err != nil {
 		return fmt.Errorf("failed to initialize providers: %w", err)
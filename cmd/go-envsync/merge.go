@@ -0,0 +1,125 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+)
+
+// MergeCommand flags
+var (
+	mergeSources      []string
+	mergeTargets      []string
+	mergeSchema       string
+	mergeStrategyName string
+	mergeOutputDir    string
+	mergeNoMetadata   bool
+	mergeTimeout      time.Duration
+)
+
+// mergeCmd represents the merge command
+var mergeCmd = &cobra.Command{
+	Use:   "merge",
+	Short: "Merge several configuration sources into one file",
+	Long: `Merge configuration from several sources in order and export the result.
+
+Sources are merged with --merge-strategy (override, preserve, error, conflict, priority).
+When the error or conflict strategy rejects a source, the number of duplicate or
+conflicting keys it shares with the sources before it is reported. Validation only runs when --validate
+is given, and applies to the merged result.
+
+Examples:
+  go-envsync merge --from=.env --from=.env.prod --to=env:merged.env
+  go-envsync merge --from=.env --from=.env.prod --merge-strategy=conflict --to=env:merged.env
+  go-envsync merge --from=base.yaml --from=.env.local --validate=schema.json --to=json:-`,
+	RunE: runMergeCommand,
+}
+
+func init() {
+	// Add merge command to root
+	rootCmd.AddCommand(mergeCmd)
+
+	// Define flags
+	mergeCmd.Flags().StringArrayVar(&mergeSources, "from", []string{}, "Configuration sources to merge, in order")
+	mergeCmd.Flags().StringArrayVar(&mergeTargets, "to", []string{},
+		"Target format and destination (format:path, or a path with a known extension), may be repeated")
+	mergeCmd.Flags().StringVar(&mergeSchema, "validate", "", "JSON schema file for validating the merged result")
+	mergeCmd.Flags().StringVar(&mergeStrategyName, "merge-strategy", DefaultMergeStrategy,
+		"Merge strategy for multiple sources (override, preserve, error, conflict, priority)")
+	mergeCmd.Flags().StringVar(&mergeOutputDir, "output-dir", ".", "Output directory for exported files")
+	mergeCmd.Flags().BoolVar(&mergeNoMetadata, "no-metadata", false,
+		"Omit the metadata header (.env) and metadata object (JSON/YAML) from exported files")
+	mergeCmd.Flags().DurationVar(&mergeTimeout, "timeout", DefaultTimeout, "Timeout for merge operations")
+
+	// Mark required flags
+	for _, name := range []string{"from", "to"} {
+		if err := mergeCmd.MarkFlagRequired(name); err != nil {
+			panic(fmt.Sprintf("failed to mark '%s' flag as required: %v", name, err))
+		}
+	}
+}
+
+// runMergeCommand executes the merge command.
+func runMergeCommand(_ *cobra.Command, _ []string) error {
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), mergeTimeout)
+	defer cancel()
+
+	// Expand glob patterns in local sources
+	sources, err := expandSourceGlobs(mergeSources)
+	if err != nil {
+		return err
+	}
+	mergeSources = sources
+
+	if len(mergeSources) > MaxSources {
+		return fmt.Errorf("too many sources: %d > %d", len(mergeSources), MaxSources)
+	}
+
+	// Parse merge strategy
+	mergeStrategy, err := parseMergeStrategy(mergeStrategyName)
+	if err != nil {
+		return err
+	}
+
+	// Create client with providers and exporter
+	envClient := client.New()
+	envClient.SetLogger(newConsoleLogger())
+	setupProviders(envClient)
+	envClient.SetExporter(exporter.NewMultiFormatExporterWithOptions(mergeOutputDir, exporter.Options{
+		NoMetadata: mergeNoMetadata,
+	}))
+
+	// Setup validator only when requested
+	if mergeSchema != "" {
+		if err := setupValidator(envClient, []string{mergeSchema}, false); err != nil {
+			return fmt.Errorf("failed to setup validator: %w", err)
+		}
+	}
+
+	// Merge sources
+	status := progress(statusWriter(mergeTargets))
+	env, err := envClient.Load(ctx, client.LoadOptions{
+		Sources:       mergeSources,
+		Schema:        mergeSchema,
+		MergeStrategy: mergeStrategy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to merge configuration: %w", err)
+	}
+
+	fmt.Fprintf(status, "Merged %d keys from %d sources\n", env.Size(), len(env.Sources))
+
+	// Export to every target
+	if err := exportConfiguration(ctx, env, mergeTargets, false, status); err != nil {
+		return fmt.Errorf("failed to export merged configuration: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+
+	"github.com/Gosayram/go-envsync/pkg/validator"
+)
+
+// promptSecret reads the value of a missing key from the terminal without echoing it.
+// It fails instead of waiting for input when stdin is not a terminal, and gives up when the
+// context is done, restoring the terminal so echo is not left disabled.
+func promptSecret(ctx context.Context, key string) (string, error) {
+	// #nosec G115 - file descriptors fit in an int
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("stdin is not a terminal")
+	}
+	state, err := term.GetState(fd)
+	if err != nil {
+		return "", fmt.Errorf("failed to read terminal state: %w", err)
+	}
+
+	// Read in the background; the buffered channel lets an abandoned read finish and exit
+	type readResult struct {
+		value []byte
+		err   error
+	}
+	results := make(chan readResult, 1)
+
+	fmt.Fprintf(os.Stderr, "Enter value for %s: ", key)
+	go func() {
+		value, err := term.ReadPassword(fd)
+		results <- readResult{value: value, err: err}
+	}()
+
+	select {
+	case result := <-results:
+		fmt.Fprintln(os.Stderr)
+		if result.err != nil {
+			return "", fmt.Errorf("failed to read value: %w", result.err)
+		}
+		return string(result.value), nil
+	case <-ctx.Done():
+		fmt.Fprintln(os.Stderr)
+		_ = term.Restore(fd, state)
+		return "", ctx.Err()
+	}
+}
+
+// promptRequiredKeys returns the keys --prompt-missing asks for: those given with --require
+// and those the schemas list as required.
+func promptRequiredKeys(required, schemaPaths []string) ([]string, error) {
+	keys := append([]string(nil), required...)
+	for _, schemaPath := range schemaPaths {
+		schemaRequired, err := validator.SchemaRequired(schemaPath)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, schemaRequired...)
+	}
+	return keys, nil
+}
@@ -2,12 +2,18 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"sort"
+	"io"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
+	"github.com/Gosayram/go-envsync/pkg/client"
 	"github.com/Gosayram/go-envsync/pkg/providers/registry"
 )
 
@@ -24,12 +30,28 @@ const (
 
 	// MaxAliasesDisplay defines the maximum number of aliases to display.
 	MaxAliasesDisplay = 15
+
+	// OutputFormatTable is the default human-readable output format.
+	OutputFormatTable = "table"
+
+	// OutputFormatJSON is the JSON output format.
+	OutputFormatJSON = "json"
+
+	// OutputFormatYAML is the YAML output format.
+	OutputFormatYAML = "yaml"
 )
 
 // ProvidersCommand flags
 var (
 	providersShowDetails bool
 	providersFilter      string
+	providersFormat      string
+)
+
+// ProvidersTestCommand flags
+var (
+	providersTestConfig  []string
+	providersTestTimeout time.Duration
 )
 
 // providersCmd represents the providers command
@@ -44,10 +66,28 @@ from different sources. Each provider has a name, aliases, and supported source
 Examples:
   go-envsync providers                    # List all providers
   go-envsync providers --details          # Show detailed information
-  go-envsync providers --filter=local     # Filter by provider name`,
+  go-envsync providers --filter=local     # Filter by provider name
+  go-envsync providers --format=json      # Machine-readable output`,
 	RunE: runProvidersCommand,
 }
 
+// providersTestCmd represents the providers test command
+var providersTestCmd = &cobra.Command{
+	Use:   "test <name>",
+	Short: "Test the connection of a configuration provider",
+	Long: `Create a provider with the given configuration and attempt a real connection to its backend.
+
+Unlike doctor, which runs a broad health check across providers, this command runs one
+provider's connection test and reports the underlying error on failure. For local the
+base path must be a readable directory; remote providers authenticate with their credentials.
+
+Examples:
+  go-envsync providers test local --config base_path=./config
+  go-envsync providers test vault --config address=https://vault:8200,token=s.xxxx`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProvidersTestCommand,
+}
+
 func init() {
 	// Add providers command to root
 	rootCmd.AddCommand(providersCmd)
@@ -55,25 +95,45 @@ func init() {
 	// Define flags
 	providersCmd.Flags().BoolVar(&providersShowDetails, "details", false, "Show detailed provider information")
 	providersCmd.Flags().StringVar(&providersFilter, "filter", "", "Filter providers by name or alias")
+	providersCmd.Flags().StringVar(&providersFormat, "format", OutputFormatTable, "Output format (table, json, yaml)")
+
+	// Add test subcommand
+	providersCmd.AddCommand(providersTestCmd)
+	providersTestCmd.Flags().StringArrayVar(&providersTestConfig, "config", []string{},
+		"Provider configuration as key=value (repeatable)")
+	providersTestCmd.Flags().DurationVar(&providersTestTimeout, "timeout", DefaultDoctorTimeout,
+		"Timeout for the connection test")
 }
 
 // runProvidersCommand executes the providers command.
 func runProvidersCommand(_ *cobra.Command, _ []string) error {
-	// Get all registered providers
-	providerNames := registry.GetProviderNames()
+	// Get all registered providers ordered by priority
+	providerInfos := registry.ListProvidersByPriority()
 
-	if len(providerNames) == 0 {
+	if len(providerInfos) == 0 {
 		fmt.Println("No providers registered")
 		return nil
 	}
 
+	providerNames := make([]string, 0, len(providerInfos))
+	for _, info := range providerInfos {
+		providerNames = append(providerNames, info.Name)
+	}
+
 	// Filter providers if requested
 	if providersFilter != "" {
 		providerNames = filterProviders(providerNames, providersFilter)
 	}
 
-	// Sort providers
-	sort.Strings(providerNames)
+	// Use structured output if requested
+	format := strings.ToLower(providersFormat)
+	if format == OutputFormatJSON || format == OutputFormatYAML {
+		return showStructuredProviders(providerNames, format)
+	}
+
+	if format != OutputFormatTable {
+		return fmt.Errorf("unsupported output format: %s (valid: table, json, yaml)", providersFormat)
+	}
 
 	if providersShowDetails {
 		return showDetailedProviders(providerNames)
@@ -82,6 +142,59 @@ func runProvidersCommand(_ *cobra.Command, _ []string) error {
 	return showProviderList(providerNames)
 }
 
+// runProvidersTestCommand executes the providers test command.
+func runProvidersTestCommand(_ *cobra.Command, args []string) error {
+	// Parse provider configuration
+	config, err := parseConfigAssignments(providersTestConfig)
+	if err != nil {
+		return err
+	}
+
+	info, err := registry.GetProvider(args[0])
+	if err != nil {
+		return err
+	}
+
+	// Create provider through its registry factory
+	provider, err := registry.CreateProvider(info.Name, config)
+	if err != nil {
+		return fmt.Errorf("failed to create provider %s: %w", info.Name, err)
+	}
+
+	tester, ok := provider.(client.ConnectionTester)
+	if !ok {
+		return fmt.Errorf("provider %s does not support connection tests", info.Name)
+	}
+
+	status := progress(os.Stdout)
+	fmt.Fprintf(status, "Testing connection to %s...\n", info.Name)
+
+	ctx, cancel := context.WithTimeout(context.Background(), providersTestTimeout)
+	defer cancel()
+
+	if err := tester.TestConnection(ctx); err != nil {
+		return fmt.Errorf("connection to %s failed: %w", info.Name, err)
+	}
+
+	fmt.Fprintf(status, "Connection to %s succeeded\n", info.Name)
+	return nil
+}
+
+// parseConfigAssignments parses key=value assignments into a provider configuration map.
+func parseConfigAssignments(assignments []string) (map[string]interface{}, error) {
+	config := make(map[string]interface{}, len(assignments))
+
+	for _, assignment := range assignments {
+		parts := strings.SplitN(assignment, "=", ConfigAssignmentParts)
+		if len(parts) != ConfigAssignmentParts || parts[0] == "" {
+			return nil, fmt.Errorf("invalid config assignment, expected 'key=value', got: %s", assignment)
+		}
+		config[parts[0]] = parts[1]
+	}
+
+	return config, nil
+}
+
 // filterProviders filters providers by name, alias, or description.
 func filterProviders(allProviders []string, filter string) []string {
 	var filtered []string
@@ -101,15 +214,16 @@ func filterProviders(allProviders []string, filter string) []string {
 		}
 
 		// Check aliases
+		aliasMatched := false
 		for _, alias := range providerInfo.Aliases {
 			if strings.Contains(strings.ToLower(alias), filterLower) {
-				filtered = append(filtered, providerName)
+				aliasMatched = true
 				break
 			}
 		}
 
 		// Check description
-		if strings.Contains(strings.ToLower(providerInfo.Description), filterLower) {
+		if aliasMatched || strings.Contains(strings.ToLower(providerInfo.Description), filterLower) {
 			filtered = append(filtered, providerName)
 		}
 	}
@@ -117,6 +231,33 @@ func filterProviders(allProviders []string, filter string) []string {
 	return filtered
 }
 
+// showStructuredProviders writes provider information as JSON or YAML.
+func showStructuredProviders(providerNames []string, format string) error {
+	infos := make([]*registry.ProviderInfo, 0, len(providerNames))
+	for _, name := range providerNames {
+		providerInfo, err := registry.GetProvider(name)
+		if err != nil {
+			continue // Skip if provider not found
+		}
+		infos = append(infos, providerInfo)
+	}
+
+	return writeStructured(os.Stdout, infos, format)
+}
+
+// writeStructured encodes a value to the writer as JSON or YAML.
+func writeStructured(w io.Writer, value interface{}, format string) error {
+	if format == OutputFormatYAML {
+		encoder := yaml.NewEncoder(w)
+		defer encoder.Close()
+		return encoder.Encode(value)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
 // showProviderList displays a simple list of providers.
 func showProviderList(providerNames []string) error {
 	fmt.Printf("Available providers (%d):\n\n", len(providerNames))
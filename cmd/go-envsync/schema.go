@@ -0,0 +1,139 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/validator"
+)
+
+// SchemaCommand flags
+var (
+	schemaPath   string
+	schemaFormat string
+)
+
+// schemaCmd represents the schema command
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Check and describe JSON schema files",
+	Long: `Work with the JSON schema files used by 'load --validate' and 'validate --schema'
+without loading any configuration.
+
+Examples:
+  go-envsync schema validate --schema=schema.json
+  go-envsync schema explain --schema=schema.json --format=json`,
+}
+
+// schemaValidateCmd represents the schema validate command
+var schemaValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check that a schema file is a valid JSON schema",
+	Long: `Load a schema file the way validation does and report syntax and schema errors.
+
+The schema must allow an object at the top level, since configuration is validated as
+one. Required keys missing from properties are reported as warnings. The command exits
+with an error when the schema is invalid.
+
+Examples:
+  go-envsync schema validate --schema=schema.json`,
+	RunE: runSchemaValidateCommand,
+}
+
+// schemaExplainCmd represents the schema explain command
+var schemaExplainCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "List the keys a schema declares, with their types and whether they are required",
+	Long: `List every key a schema declares in properties or lists as required, with its type,
+whether it is required, its default and its description.
+
+Examples:
+  go-envsync schema explain --schema=schema.json
+  go-envsync schema explain --schema=schema.json --format=yaml`,
+	RunE: runSchemaExplainCommand,
+}
+
+func init() {
+	// Add schema command to root
+	rootCmd.AddCommand(schemaCmd)
+	schemaCmd.AddCommand(schemaValidateCmd, schemaExplainCmd)
+
+	// Define flags
+	schemaCmd.PersistentFlags().StringVar(&schemaPath, "schema", "", "JSON schema file")
+	schemaExplainCmd.Flags().StringVar(&schemaFormat, "format", OutputFormatTable, "Output format (table, json, yaml)")
+
+	// Mark required flags
+	if err := schemaCmd.MarkPersistentFlagRequired("schema"); err != nil {
+		panic(fmt.Sprintf("failed to mark 'schema' flag as required: %v", err))
+	}
+}
+
+// runSchemaValidateCommand executes the schema validate command.
+func runSchemaValidateCommand(cmd *cobra.Command, _ []string) error {
+	warnings, err := validator.CheckSchema(schemaPath)
+	if err != nil {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("invalid schema %s: %w", schemaPath, err)
+	}
+
+	logger := newConsoleLogger()
+	for _, warning := range warnings {
+		logger.Warnf("%s", warning)
+	}
+	fmt.Fprintf(progress(os.Stdout), "Schema %s is valid\n", schemaPath)
+	return nil
+}
+
+// runSchemaExplainCommand executes the schema explain command.
+func runSchemaExplainCommand(_ *cobra.Command, _ []string) error {
+	// Validate output format
+	format := strings.ToLower(schemaFormat)
+	if format != OutputFormatTable && format != OutputFormatJSON && format != OutputFormatYAML {
+		return fmt.Errorf("unsupported output format: %s (valid: table, json, yaml)", schemaFormat)
+	}
+
+	keys, err := validator.DescribeSchema(schemaPath)
+	if err != nil {
+		return err
+	}
+
+	if format != OutputFormatTable {
+		return writeStructured(os.Stdout, keys, format)
+	}
+
+	fmt.Printf("Schema %s (%d keys):\n", schemaPath, len(keys))
+	for _, key := range keys {
+		fmt.Printf("  %s\n", describeSchemaKey(key))
+	}
+	return nil
+}
+
+// describeSchemaKey formats a schema key as one line of the explain table.
+func describeSchemaKey(key validator.SchemaKey) string {
+	var details []string
+	if key.Type != "" {
+		details = append(details, key.Type)
+	}
+	if key.Required {
+		details = append(details, "required")
+	}
+	if !key.Declared {
+		details = append(details, "not declared in properties")
+	}
+	if key.Default != "" {
+		details = append(details, "default "+key.Default)
+	}
+
+	line := key.Key
+	if len(details) > 0 {
+		line += " (" + strings.Join(details, ", ") + ")"
+	}
+	if key.Description != "" {
+		line += ": " + key.Description
+	}
+	return line
+}
@@ -0,0 +1,77 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// Constants for set command
+const (
+	// KeyValueParts defines the expected number of parts in a KEY=VALUE argument.
+	KeyValueParts = 2
+)
+
+// SetCommand flags
+var (
+	setFile   string
+	setCreate bool
+)
+
+// setCmd represents the set command
+var setCmd = &cobra.Command{
+	Use:   "set KEY=VALUE [KEY=VALUE...]",
+	Short: "Add or update keys in a .env file",
+	Long: `Add or update one or more keys in a .env file.
+
+Existing definitions are replaced in place and new keys are appended. All other
+lines, including comments and blank lines, are preserved. Values are quoted
+when needed using the same rules as the .env exporter.
+
+Examples:
+  go-envsync set DATABASE_URL=postgres://localhost/app
+  go-envsync set LOG_LEVEL=debug PORT=8080 --file=.env.local --create`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runSetCommand,
+}
+
+func init() {
+	// Add set command to root
+	rootCmd.AddCommand(setCmd)
+
+	// Define flags
+	setCmd.Flags().StringVar(&setFile, "file", local.DefaultEnvFile, ".env file to update")
+	setCmd.Flags().BoolVar(&setCreate, "create", false, "Create the file if it does not exist")
+}
+
+// runSetCommand executes the set command.
+func runSetCommand(_ *cobra.Command, args []string) error {
+	// Parse KEY=VALUE arguments
+	keys := make([]string, 0, len(args))
+	values := make(map[string]string, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", KeyValueParts)
+		if len(parts) != KeyValueParts || parts[0] == "" {
+			return fmt.Errorf("invalid assignment %q, expected KEY=VALUE", arg)
+		}
+
+		if _, exists := values[parts[0]]; !exists {
+			keys = append(keys, parts[0])
+		}
+		values[parts[0]] = parts[1]
+	}
+
+	// Update file
+	provider := local.NewProvider()
+	if err := provider.SetValues(setFile, keys, values, setCreate); err != nil {
+		return fmt.Errorf("failed to update %s: %w", setFile, err)
+	}
+
+	fmt.Fprintf(progress(os.Stdout), "Updated %d keys in %s\n", len(keys), setFile)
+	return nil
+}
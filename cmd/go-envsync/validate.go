@@ -0,0 +1,253 @@
+// Package main contains CLI command implementations for go-envsync.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/validator"
+)
+
+// Constants for validate command
+const (
+	// RuleValidator names failures of validators that do not report structured errors.
+	RuleValidator = "validator"
+)
+
+// ValidateCommand flags
+var (
+	validateSources       []string
+	validateSchemas       []string
+	validateRejectUnknown bool
+	validateKeyCase       string
+	validateCheckEncoding bool
+	validateRequireIf     []string
+	validateExample       string
+	validateAllowKeys     []string
+	validateDenyKeys      []string
+	validateMergeStrategy string
+	validateMaskKeys      []string
+	validateNoMask        bool
+	validateFormat        string
+	validateTimeout       time.Duration
+)
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate configuration against a schema and rules",
+	Long: `Load configuration from one or more sources and run every requested check on it.
+
+Unlike load, all checks run even when an earlier one fails, and each failure is reported
+with the key, its (masked) value, the rule that failed and a message. With --format=json
+the failures are written to stdout as a JSON array for CI annotations. The command exits
+with an error when any check fails.
+
+Examples:
+  go-envsync validate --from=.env --schema=schema.json
+  go-envsync validate --from=.env --key-case=upper_snake --check-encoding
+  go-envsync validate --from=.env --schema=schema.json --format=json`,
+	RunE: runValidateCommand,
+}
+
+func init() {
+	// Add validate command to root
+	rootCmd.AddCommand(validateCmd)
+
+	// Define flags
+	validateCmd.Flags().StringSliceVar(&validateSources, "from", []string{}, "Configuration sources to load from")
+	validateCmd.Flags().StringArrayVar(&validateSchemas, "schema", []string{},
+		"JSON schema files to validate against (repeatable; every schema must pass)")
+	validateCmd.Flags().BoolVar(&validateRejectUnknown, "fail-on-missing-schema-key", false,
+		"Reject keys not described by the schema, even without additionalProperties: false")
+	validateCmd.Flags().StringVar(&validateKeyCase, "key-case", "",
+		"Require every key to follow a naming convention (upper_snake, lower_snake, kebab)")
+	validateCmd.Flags().BoolVar(&validateCheckEncoding, "check-encoding", false,
+		"Reject values with invalid UTF-8 or control characters other than tab and line breaks")
+	validateCmd.Flags().StringArrayVar(&validateRequireIf, "require-if", []string{},
+		"Require keys when another key has a value, as KEY=VALUE:REQUIRED[,REQUIRED...], may be repeated")
+	validateCmd.Flags().StringVar(&validateExample, "against-example", "",
+		"Require every key listed in a .env.example file to be present (values are ignored)")
+	validateCmd.Flags().StringSliceVar(&validateAllowKeys, "allow-keys", []string{},
+		"Glob patterns of the only keys allowed in the configuration (e.g. APP_*)")
+	validateCmd.Flags().StringSliceVar(&validateDenyKeys, "deny-keys", []string{},
+		"Glob patterns of keys that must never appear in the configuration (e.g. AWS_SECRET_*)")
+	validateCmd.Flags().StringVar(&validateMergeStrategy, "merge-strategy", DefaultMergeStrategy,
+		"Merge strategy for multiple sources (override, preserve, error, conflict, priority)")
+	validateCmd.Flags().StringSliceVar(&validateMaskKeys, "mask-keys", []string{},
+		"Additional glob patterns of keys whose values are masked in output (added to built-in defaults)")
+	validateCmd.Flags().BoolVar(&validateNoMask, "no-mask", false, "Disable masking of sensitive values in output")
+	validateCmd.Flags().StringVar(&validateFormat, "format", OutputFormatTable, "Output format (table, json, yaml)")
+	validateCmd.Flags().DurationVar(&validateTimeout, "timeout", DefaultTimeout, "Timeout for load operations")
+
+	// Mark required flags
+	if err := validateCmd.MarkFlagRequired("from"); err != nil {
+		panic(fmt.Sprintf("failed to mark 'from' flag as required: %v", err))
+	}
+}
+
+// runValidateCommand executes the validate command.
+func runValidateCommand(cmd *cobra.Command, _ []string) error {
+	// Validate output format
+	format := strings.ToLower(validateFormat)
+	if format != OutputFormatTable && format != OutputFormatJSON && format != OutputFormatYAML {
+		return fmt.Errorf("unsupported output format: %s (valid: table, json, yaml)", validateFormat)
+	}
+
+	// Build the checks to run
+	validators, err := buildValidateCheckers()
+	if err != nil {
+		return err
+	}
+	if len(validators) == 0 {
+		return fmt.Errorf("no checks requested: use --schema, --key-case, --check-encoding, " +
+			"--require-if, --against-example, --allow-keys or --deny-keys")
+	}
+
+	masker, err := buildMasker(validateMaskKeys, validateNoMask)
+	if err != nil {
+		return err
+	}
+
+	mergeStrategy, err := parseMergeStrategy(validateMergeStrategy)
+	if err != nil {
+		return err
+	}
+
+	sources, err := expandSourceGlobs(validateSources)
+	if err != nil {
+		return err
+	}
+
+	// Load configuration without a validator, so every check can run
+	ctx, cancel := context.WithTimeout(context.Background(), validateTimeout)
+	defer cancel()
+
+	envClient := client.New()
+	envClient.SetLogger(newConsoleLogger())
+	setupProviders(envClient)
+
+	env, err := envClient.Load(ctx, client.LoadOptions{
+		Sources:       sources,
+		MergeStrategy: mergeStrategy,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	// Run every check and collect failures
+	failures := make([]validator.FieldError, 0)
+	for _, check := range validators {
+		checkErr := check.Validate(ctx, env.Data)
+		if checkErr == nil {
+			continue
+		}
+
+		fields := validator.FieldErrors(checkErr)
+		if fields == nil {
+			fields = []validator.FieldError{{Rule: RuleValidator, Message: checkErr.Error()}}
+		}
+		failures = append(failures, fields...)
+	}
+
+	// Mask values of sensitive keys
+	for i := range failures {
+		failures[i].Value = masker.Mask(failures[i].Key, failures[i].Value)
+	}
+
+	// Report failures
+	if format == OutputFormatTable {
+		printValidationFailures(failures)
+	} else if err := writeStructured(os.Stdout, failures, format); err != nil {
+		return err
+	}
+
+	if len(failures) > 0 {
+		// The failures are already reported; usage is not what went wrong
+		cmd.SilenceUsage = true
+		return fmt.Errorf("validation failed: %d issues found", len(failures))
+	}
+
+	return nil
+}
+
+// buildValidateCheckers creates the validators requested by the validate command flags.
+func buildValidateCheckers() ([]validator.Validator, error) {
+	var validators []validator.Validator
+
+	// Add schema validator
+	if len(validateSchemas) > 0 {
+		schemaValidator, err := newSchemaValidator(validateSchemas, validateRejectUnknown)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup validator: %w", err)
+		}
+		validators = append(validators, schemaValidator)
+	} else if validateRejectUnknown {
+		return nil, fmt.Errorf("--fail-on-missing-schema-key requires --schema")
+	}
+
+	// Add custom rules
+	rules, err := buildValidationRules(validateKeyCase, validateCheckEncoding)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) > 0 {
+		validators = append(validators, validator.NewCustomValidator(rules...))
+	}
+
+	// Add conditional required keys
+	conditionalRules, err := parseConditionalRules(validateRequireIf)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range conditionalRules {
+		validators = append(validators, rule)
+	}
+
+	// Add .env.example contract check
+	if validateExample != "" {
+		example, err := validator.NewExampleValidator(validateExample)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, example)
+	}
+
+	// Add key allowlist and denylist
+	if len(validateAllowKeys) > 0 || len(validateDenyKeys) > 0 {
+		policy, err := validator.NewKeyPolicyValidator(validateAllowKeys, validateDenyKeys)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, policy)
+	}
+
+	return validators, nil
+}
+
+// printValidationFailures prints one line per failed check.
+func printValidationFailures(failures []validator.FieldError) {
+	if len(failures) == 0 {
+		fmt.Fprintln(progress(os.Stdout), "Configuration is valid")
+		return
+	}
+
+	fmt.Printf("Validation failed (%d issues):\n", len(failures))
+	for _, failure := range failures {
+		message := failure.Message
+		if failure.Schema != "" {
+			message += " (" + failure.Schema + ")"
+		}
+		if failure.Key == "" {
+			fmt.Printf("  [%s] %s\n", failure.Rule, message)
+			continue
+		}
+		fmt.Printf("  %s [%s] %s\n", failure.Key, failure.Rule, message)
+	}
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Constants for typed accessors
+const (
+	// FloatBitSize defines the bit size used when parsing float values.
+	FloatBitSize = 64
+)
+
+// ErrKeyNotFound is returned by typed accessors when a key is absent.
+var ErrKeyNotFound = errors.New("key not found")
+
+// lookup returns the value for the key or a wrapped ErrKeyNotFound.
+func (e *Environment) lookup(key string) (string, error) {
+	value, exists := e.Data[key]
+	if !exists {
+		return "", fmt.Errorf("%w: %s", ErrKeyNotFound, key)
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+// GetInt returns the value for the specified key parsed as an int.
+func (e *Environment) GetInt(key string) (int, error) {
+	value, err := e.lookup(key)
+	if err != nil {
+		return 0, err
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid int value for key %s: %w", key, err)
+	}
+
+	return parsed, nil
+}
+
+// GetBool returns the value for the specified key parsed as a bool.
+// Accepted values are those understood by strconv.ParseBool.
+func (e *Environment) GetBool(key string) (bool, error) {
+	value, err := e.lookup(key)
+	if err != nil {
+		return false, err
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid bool value for key %s: %w", key, err)
+	}
+
+	return parsed, nil
+}
+
+// GetDuration returns the value for the specified key parsed as a time.Duration.
+func (e *Environment) GetDuration(key string) (time.Duration, error) {
+	value, err := e.lookup(key)
+	if err != nil {
+		return 0, err
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration value for key %s: %w", key, err)
+	}
+
+	return parsed, nil
+}
+
+// GetFloat returns the value for the specified key parsed as a float64.
+func (e *Environment) GetFloat(key string) (float64, error) {
+	value, err := e.lookup(key)
+	if err != nil {
+		return 0, err
+	}
+
+	parsed, err := strconv.ParseFloat(value, FloatBitSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid float value for key %s: %w", key, err)
+	}
+
+	return parsed, nil
+}
+
+// GetIntDefault returns the int value for the key, or def if it is missing or invalid.
+func (e *Environment) GetIntDefault(key string, def int) int {
+	value, err := e.GetInt(key)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// GetBoolDefault returns the bool value for the key, or def if it is missing or invalid.
+func (e *Environment) GetBoolDefault(key string, def bool) bool {
+	value, err := e.GetBool(key)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// GetDurationDefault returns the duration value for the key, or def if it is missing or invalid.
+func (e *Environment) GetDurationDefault(key string, def time.Duration) time.Duration {
+	value, err := e.GetDuration(key)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// GetFloatDefault returns the float value for the key, or def if it is missing or invalid.
+func (e *Environment) GetFloatDefault(key string, def float64) float64 {
+	value, err := e.GetFloat(key)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// GetBytes returns the value for the specified key decoded from base64.
+// Both padded and unpadded standard encodings are accepted.
+func (e *Environment) GetBytes(key string) ([]byte, error) {
+	value, err := e.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		var rawErr error
+		if decoded, rawErr = base64.RawStdEncoding.DecodeString(value); rawErr != nil {
+			return nil, fmt.Errorf("invalid base64 value for key %s: %w", key, err)
+		}
+	}
+
+	return decoded, nil
+}
+
+// GetJSON unmarshals the JSON value for the specified key into out.
+func (e *Environment) GetJSON(key string, out interface{}) error {
+	value, err := e.lookup(key)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal([]byte(value), out); err != nil {
+		return fmt.Errorf("invalid JSON value for key %s: %w", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Constants for baseline fingerprints
+const (
+	// FingerprintPrefix prefixes fingerprints with the algorithm that produced them.
+	FingerprintPrefix = "hmac-sha256:"
+
+	// FingerprintSaltSize is the size in bytes of salts generated by NewFingerprintSalt.
+	FingerprintSaltSize = 32
+)
+
+// NewFingerprintSalt returns a random salt for the fingerprints of one baseline.
+func NewFingerprintSalt() ([]byte, error) {
+	salt := make([]byte, FingerprintSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate fingerprint salt: %w", err)
+	}
+	return salt, nil
+}
+
+// Fingerprint returns a one-way fingerprint of a value, the HMAC-SHA256 of the value keyed
+// with salt, so that changes can be detected without storing the value itself. The salt
+// prevents precomputed lookups and comparing fingerprints across baselines, but anyone
+// holding both the salt and a fingerprint can still test guesses of a low-entropy value,
+// so baselines must be protected like the configuration they describe.
+func Fingerprint(value string, salt []byte) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(value))
+	return FingerprintPrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Fingerprinted returns a copy of the environment with every value replaced by its
+// Fingerprint under salt. Diffing two environments fingerprinted with the same salt
+// reports the same keys as diffing the originals, so it can be persisted with its salt as
+// a baseline for rotation detection. A nil environment yields an empty one.
+func (e *Environment) Fingerprinted(salt []byte) *Environment {
+	fingerprinted := &Environment{Data: make(map[string]string)}
+	if e == nil {
+		return fingerprinted
+	}
+
+	fingerprinted.Sources = e.Sources
+	for key, value := range e.Data {
+		fingerprinted.Data[key] = Fingerprint(value, salt)
+	}
+
+	return fingerprinted
+}
@@ -3,8 +3,12 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"sort"
 	"strings"
+	"time"
 )
 
 // Constants for client configuration
@@ -29,6 +33,20 @@ const (
 
 	// MinSourceParts defines the minimum number of parts required for provider:source parsing.
 	MinSourceParts = 2
+
+	// DefaultProviderPriority is the priority of providers without an explicit priority.
+	// Lower values take precedence under MergeStrategyPriority.
+	DefaultProviderPriority = 50
+
+	// DefaultsSource is the provenance reported for keys injected from LoadOptions.Defaults.
+	DefaultsSource = "defaults"
+
+	// PromptSource is the provenance reported for keys entered through LoadOptions.PromptMissing.
+	PromptSource = "prompt"
+
+	// MergedSource is the provenance reported for keys taken by Environment.Merge from an
+	// environment that recorded no source for them.
+	MergedSource = "merged"
 )
 
 // MergeStrategy defines how to handle conflicting keys from multiple sources.
@@ -43,8 +61,34 @@ const (
 
 	// MergeStrategyError returns an error if duplicate keys are found.
 	MergeStrategyError
+
+	// MergeStrategyPriority resolves conflicts by provider priority instead of source order:
+	// a value from a provider with a lower priority number wins. Equal priorities override.
+	MergeStrategyPriority
+
+	// MergeStrategyErrorOnConflict returns an error only if a duplicate key has a different value.
+	// Identical re-definitions merge silently.
+	MergeStrategyErrorOnConflict
 )
 
+// String returns the strategy name as accepted by the CLI --merge-strategy flag.
+func (s MergeStrategy) String() string {
+	switch s {
+	case MergeStrategyOverride:
+		return "override"
+	case MergeStrategyPreserve:
+		return "preserve"
+	case MergeStrategyError:
+		return "error"
+	case MergeStrategyPriority:
+		return "priority"
+	case MergeStrategyErrorOnConflict:
+		return "conflict"
+	default:
+		return fmt.Sprintf("MergeStrategy(%d)", int(s))
+	}
+}
+
 // Provider defines the interface for configuration providers.
 type Provider interface {
 	// Name returns the provider name.
@@ -57,29 +101,86 @@ type Provider interface {
 	Validate(source string) error
 }
 
+// HealthChecker is an optional interface for providers that can verify their backend is reachable.
+type HealthChecker interface {
+	// HealthCheck returns an error if the provider backend is unreachable or unusable.
+	HealthCheck(ctx context.Context) error
+}
+
+// ErrHealthCheckUnsupported is returned, possibly wrapped, by HealthCheck implementations
+// that cannot check their backend yet, so callers can report the check as skipped.
+var ErrHealthCheckUnsupported = errors.New("health check not supported")
+
+// ConnectionTester is an optional interface for providers that can attempt a real connection
+// to their backend with the configured credentials, such as authenticating against a server.
+type ConnectionTester interface {
+	// TestConnection connects to the backend and returns the underlying error on failure.
+	TestConnection(ctx context.Context) error
+}
+
 // Validator defines the interface for configuration validation.
 type Validator interface {
 	// Validate validates the configuration.
 	Validate(ctx context.Context, config map[string]string) error
 }
 
+// WarningValidator is an optional interface for validators that report non-fatal issues.
+// Client.Load calls ValidateWithWarnings instead of Validate and records the warnings on
+// the environment after validation succeeds.
+type WarningValidator interface {
+	// ValidateWithWarnings validates like Validate and also returns the non-fatal issues found.
+	ValidateWithWarnings(ctx context.Context, config map[string]string) ([]string, error)
+}
+
 // Exporter defines the interface for configuration export.
 type Exporter interface {
 	// Export exports configuration to the specified destination.
 	Export(ctx context.Context, config map[string]string, destination string) error
 }
 
+// ChangeReportingExporter is an optional interface for exporters that skip unchanged destinations.
+type ChangeReportingExporter interface {
+	// ExportChanged exports configuration and reports whether the destination was written.
+	ExportChanged(ctx context.Context, config map[string]string, destination string) (bool, error)
+}
+
+// Renderer is an optional interface for exporters that can return serialized configuration
+// in memory instead of writing it to a destination.
+type Renderer interface {
+	// Render serializes configuration in the given format.
+	Render(config map[string]string, format string) ([]byte, error)
+}
+
 // Client is the main client for go-envsync operations.
+//
+// Configure a client with its Add* and Set* methods before loading; they are not safe to
+// call concurrently with each other or with Load. Once configured, Load and LoadMany may be
+// called from many goroutines: each call builds its own Environment and only reads the
+// client's configuration. The providers, validator, transformers and exporter are shared
+// by those calls, so they must be safe for concurrent use too. The built-in providers are;
+// validators that report warnings, such as lenient CustomValidator and ExampleValidator,
+// keep their warnings from the last call and are not, so give concurrent loads using them
+// a client each.
 type Client struct {
-	providers map[string]Provider
-	validator Validator
-	exporter  Exporter
+	providers  map[string]Provider
+	priorities map[string]int
+	timeouts   map[string]time.Duration
+	validator  Validator
+	exporter   Exporter
+	logger     Logger
+
+	transformers    []ValueTransformer
+	keyTransformers []KeyTransformer
+	sourceRewriter  SourceRewriter
 }
 
 // New creates a new go-envsync client.
 func New() *Client {
 	return &Client{
-		providers: make(map[string]Provider),
+		providers:  make(map[string]Provider),
+		priorities: make(map[string]int),
+		timeouts:   make(map[string]time.Duration),
+		logger:     NopLogger(),
 	}
 }
 
@@ -91,26 +192,140 @@ func (c *Client) AddProvider(name string, provider Provider) {
 	c.providers[name] = provider
 }
 
+// SetProviderPriority sets the priority used by MergeStrategyPriority for a provider.
+// Lower values take precedence.
+func (c *Client) SetProviderPriority(name string, priority int) {
+	c.priorities[name] = priority
+}
+
+// providerPriority returns the priority of a provider.
+func (c *Client) providerPriority(name string) int {
+	if priority, exists := c.priorities[name]; exists {
+		return priority
+	}
+	return DefaultProviderPriority
+}
+
 // SetValidator sets the configuration validator.
 func (c *Client) SetValidator(validator Validator) {
 	c.validator = validator
 }
 
+// Validator returns the configured validator, or nil when none is set.
+func (c *Client) Validator() Validator {
+	return c.validator
+}
+
+// HasValidator reports whether a validator is configured.
+func (c *Client) HasValidator() bool {
+	return c.validator != nil
+}
+
 // SetExporter sets the configuration exporter.
 func (c *Client) SetExporter(exporter Exporter) {
 	c.exporter = exporter
 }
 
+// Exporter returns the configured exporter, or nil when none is set.
+func (c *Client) Exporter() Exporter {
+	return c.exporter
+}
+
 // LoadOptions defines options for loading configuration.
 type LoadOptions struct {
 	// Sources is the list of sources to load from.
 	Sources []string
 
+	// SourceSpecs lists additional sources with per-source merge strategies.
+	// They are loaded after Sources, in order.
+	SourceSpecs []SourceSpec
+
 	// Schema is the path to the JSON schema file for validation.
 	Schema string
 
 	// MergeStrategy defines how to handle conflicting keys.
 	MergeStrategy MergeStrategy
+
+	// KeyCase normalizes keys after each source is loaded, before merging.
+	// When two keys from the same source normalize to the same key, the collision
+	// is resolved with MergeStrategy (MergeStrategyError fails the load).
+	KeyCase KeyCase
+
+	// DedupeCase collapses keys that differ only by case, such as Path and PATH, into
+	// KeyCaseUpper or KeyCaseLower form after all sources are merged. The kept value is
+	// chosen with MergeStrategy. KeyCaseNone leaves such keys as they are.
+	DedupeCase KeyCase
+
+	// KeyRenames renames keys after all sources are merged, mapping each old key to its new
+	// name; the old key is removed. Renames apply at once, so two keys can swap names. When
+	// the new key is kept or several keys share a new name, the kept value is chosen with
+	// MergeStrategy, as for keys collapsed by DedupeCase. Missing old keys are ignored.
+	KeyRenames map[string]string
+
+	// ExpandOSEnv resolves ${env:NAME} references in values against the process environment
+	// after all sources are merged. This is distinct from references between loaded keys.
+	ExpandOSEnv bool
+
+	// ExpandBareOSEnv additionally resolves bare $NAME references when ExpandOSEnv is set.
+	// Note that .env files already expand bare references while parsing.
+	ExpandBareOSEnv bool
+
+	// Profile expands each source into layered sources: the base source, then
+	// "<source>.local", then "<source>.<profile>". Later layers override earlier ones
+	// regardless of MergeStrategy. Missing ".local" and profile layers are skipped
+	// silently; a missing base source is an error.
+	Profile string
+
+	// OnDuplicateInSource defines how keys defined more than once within a single
+	// source are handled, for providers implementing DuplicateDetector.
+	OnDuplicateInSource DuplicatePolicy
+
+	// ContinueOnError keeps loading the remaining sources when one fails. Load then
+	// returns the environment built from the sources that loaded together with an error
+	// joining each source's *LoadError, so callers must check both: the configuration may
+	// be missing keys the failed sources would have provided, or hold values they would
+	// have overridden. A failed source contributes nothing, not even keys, @required
+	// directives or comments it read before failing. Errors after the sources are loaded,
+	// such as validation failures, still return no environment.
+	ContinueOnError bool
+
+	// ResolveReferences replaces values of the form provider:source#key, such as
+	// vault:secret/data/db#password, with that key of the source loaded through the named
+	// provider. Only providers added to the client are recognized, so other values
+	// containing ':' and '#' are left alone. References run after defaults are applied
+	// and before OS environment expansion and templates.
+	ResolveReferences bool
+
+	// PreserveComments reads the comments documenting keys from providers implementing
+	// CommentReader into Environment.Comments, so exports can carry them over.
+	PreserveComments bool
+
+	// RequiredKeys lists keys that must be present once all sources are merged and
+	// transformed, in addition to those declared by @required directives.
+	RequiredKeys []string
+
+	// PromptMissing, when set, is called for each required key still missing, in sorted
+	// order, and the value it returns is added before validation. It receives the context of
+	// the load and should give up when it is done, since waiting for input counts against
+	// the load's deadline. Prompted values are never logged.
+	PromptMissing func(ctx context.Context, key string) (string, error)
+
+	// StrictExpansion makes references to undefined variables an error instead of
+	// expanding them to an empty string.
+	StrictExpansion bool
+
+	// Defaults are injected for keys absent after all sources are merged, before
+	// expansion, transformation and validation. See validator.SchemaDefaults.
+	Defaults map[string]string
+
+	// Template renders values containing {{ }} actions as Go text/template templates after
+	// OS environment expansion, with the merged configuration as data. See TemplateFuncs for
+	// the available functions. With StrictExpansion, references to missing keys fail.
+	Template bool
+
+	// MaxKeys limits the number of keys in the loaded environment.
+	// Zero uses MaxEnvironmentKeys.
+	MaxKeys int
 }
 
 // Environment represents a loaded configuration environment.
@@ -121,8 +336,59 @@ type Environment struct {
 	// Sources contains information about the sources.
 	Sources []SourceInfo
 
+	// Warnings contains non-fatal issues found while loading.
+	Warnings []string
+
+	// ResolvedKeys lists, in sorted order, the keys whose values were changed after merging
+	// by secret references, OS environment expansion or template rendering. ${X} references
+	// between keys of a .env file are expanded by the local provider as it reads the file
+	// and are not listed.
+	ResolvedKeys []string
+
+	// ReferencedKeys lists, in sorted order, the keys whose values were loaded through
+	// secret references with LoadOptions.ResolveReferences. Treat their values as secrets.
+	ReferencedKeys []string
+
+	// MergeReport lists the keys set by more than one source and which source won each.
+	MergeReport MergeReport
+
+	// DefaultedKeys lists, in sorted order, the keys set from LoadOptions.Defaults.
+	DefaultedKeys []string
+
+	// PromptedKeys lists, in sorted order, the keys set through LoadOptions.PromptMissing.
+	PromptedKeys []string
+
+	// Comments holds the comment documenting each key in its source, when
+	// LoadOptions.PreserveComments is set and the provider implements CommentReader.
+	Comments map[string]string
+
+	// CaseCollisions lists the keys collapsed by LoadOptions.DedupeCase.
+	CaseCollisions []CaseCollision
+
+	// RequestID identifies the load that produced this environment. It is taken from
+	// the context (see WithRequestID) or generated when the context carries none.
+	RequestID string
+
 	// client reference for export operations
 	client *Client
+
+	// keyPriorities records the provider priority that set each key during a load
+	keyPriorities map[string]int
+
+	// provenance records the source that last set each key during a load
+	provenance map[string]string
+
+	// writeOrder records when each key was last written during a load
+	writeOrder map[string]int
+
+	// writes counts the key writes during a load
+	writes int
+
+	// conflicts records the keys offered by more than one source during a load
+	conflicts map[string]*MergeConflict
+
+	// requiredKeys records the keys declared by @required directives and where
+	requiredKeys map[string]string
 }
 
 // SourceInfo contains information about a configuration source.
@@ -137,45 +403,205 @@ type SourceInfo struct {
 	KeyCount int
 }
 
+// Diff describes the differences between two environments.
+type Diff struct {
+	// Added contains keys present only in the other environment, with their values.
+	Added map[string]string
+
+	// Removed contains keys present only in the original environment, with their values.
+	Removed map[string]string
+
+	// Changed contains keys present in both with different values, as [old, new].
+	Changed map[string][2]string
+}
+
 // Load loads configuration from the specified sources.
 func (c *Client) Load(ctx context.Context, options LoadOptions) (*Environment, error) {
 	// Validate options
-	if len(options.Sources) == 0 {
+	if len(options.Sources) == 0 && len(options.SourceSpecs) == 0 {
 		return nil, fmt.Errorf("no sources specified")
 	}
 
+	// Attach a request ID shared by all sources of this load
+	ctx = ensureRequestID(ctx)
+
 	env := &Environment{
-		Data:    make(map[string]string),
-		Sources: make([]SourceInfo, 0, len(options.Sources)),
-		client:  c,
+		Data:      make(map[string]string),
+		Sources:   make([]SourceInfo, 0, len(options.Sources)+len(options.SourceSpecs)),
+		RequestID: RequestIDFromContext(ctx),
+		client:    c,
+	}
+
+	// Load from each source, collecting failures if requested
+	var sourceErrs []error
+	for _, step := range planSources(options) {
+		if !options.ContinueOnError {
+			if err := c.loadFromSource(ctx, step, env, options); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Merge into a copy, so a failed source leaves nothing of itself behind
+		scratch := env.scratchCopy()
+		if err := c.loadFromSource(ctx, step, scratch, options); err != nil {
+			env.addWarning("continuing without source %s: %v", step.source, err)
+			sourceErrs = append(sourceErrs, err)
+			continue
+		}
+		env = scratch
+	}
+
+	// Process the merged configuration
+	if err := c.finishLoad(ctx, env, options); err != nil {
+		return nil, err
+	}
+	if err := c.validateLoaded(ctx, env, options); err != nil {
+		return nil, err
+	}
+
+	// Report sources skipped by ContinueOnError alongside the partial environment
+	if len(sourceErrs) > 0 {
+		return env, errors.Join(sourceErrs...)
+	}
+
+	return env, nil
+}
+
+// finishLoad runs the stages after all sources are merged: case deduplication, renames,
+// the merge report, defaults, value resolution, transforms and required keys.
+func (c *Client) finishLoad(ctx context.Context, env *Environment, options LoadOptions) error {
+	// Collapse keys differing only by case
+	if err := c.dedupeCase(env, options.DedupeCase, options.MergeStrategy); err != nil {
+		return err
+	}
+
+	// Rename keys
+	if err := c.renameKeys(env, options.KeyRenames, options.MergeStrategy); err != nil {
+		return err
 	}
 
-	// Load from each source
-	for _, source := range options.Sources {
-		if err := c.loadFromSource(ctx, source, env, options.MergeStrategy); err != nil {
-			return nil, fmt.Errorf("failed to load from source %s: %w", source, err)
+	// Summarize keys offered by more than one source
+	env.MergeReport = env.buildMergeReport(options.MergeStrategy)
+
+	// Inject defaults for absent keys
+	env.DefaultedKeys = applyDefaults(env.Data, options.Defaults)
+	for _, key := range env.DefaultedKeys {
+		env.setProvenance(key, DefaultsSource)
+	}
+
+	// Resolve references, expansions and templates in values
+	if err := c.resolveValues(ctx, env, options); err != nil {
+		return err
+	}
+
+	// Transform merged keys and values
+	if err := c.applyKeyTransformers(env); err != nil {
+		return err
+	}
+	if err := c.applyTransformers(env.Data); err != nil {
+		return err
+	}
+
+	// Prompt for missing required keys
+	env.requireKeys(options.RequiredKeys)
+	if options.PromptMissing != nil {
+		if err := promptMissing(ctx, env, options.PromptMissing); err != nil {
+			return err
+		}
+	}
+
+	// Check required keys
+	if err := checkRequiredKeys(env); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	return nil
+}
+
+// resolveValues resolves secret references, OS environment references and value templates,
+// recording the keys whose values changed.
+func (c *Client) resolveValues(ctx context.Context, env *Environment, options LoadOptions) error {
+	// Resolve secret references
+	if options.ResolveReferences {
+		referenced, err := c.resolveReferences(ctx, env)
+		if err != nil {
+			return fmt.Errorf("reference resolution failed: %w", err)
+		}
+		env.ReferencedKeys = referenced
+		env.ResolvedKeys = append([]string(nil), referenced...)
+	}
+
+	// Expand OS environment references
+	if options.ExpandOSEnv {
+		expanded, err := expandOSEnv(env.Data, options.ExpandBareOSEnv, options.StrictExpansion)
+		if err != nil {
+			return fmt.Errorf("environment expansion failed: %w", err)
 		}
+		env.ResolvedKeys = mergeSortedKeys(env.ResolvedKeys, expanded)
 	}
 
+	// Render value templates
+	if options.Template {
+		rendered, err := renderTemplates(env.Data, options.StrictExpansion)
+		if err != nil {
+			return fmt.Errorf("template rendering failed: %w", err)
+		}
+		env.ResolvedKeys = mergeSortedKeys(env.ResolvedKeys, rendered)
+	}
+
+	return nil
+}
+
+// validateLoaded runs the validator over the final configuration, recording its warnings,
+// and checks the number of keys.
+func (c *Client) validateLoaded(ctx context.Context, env *Environment, options LoadOptions) error {
 	// Validate if validator is set
 	if c.validator != nil {
-		if err := c.validator.Validate(ctx, env.Data); err != nil {
-			return nil, fmt.Errorf("validation failed: %w", err)
+		var warnings []string
+		var err error
+		if reporter, ok := c.validator.(WarningValidator); ok {
+			warnings, err = reporter.ValidateWithWarnings(ctx, env.Data)
+		} else {
+			err = c.validator.Validate(ctx, env.Data)
+		}
+		if err != nil {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+
+		for _, warning := range warnings {
+			env.addWarning("validation: %s", warning)
 		}
 	}
 
 	// Check environment size
-	if len(env.Data) > MaxEnvironmentKeys {
-		return nil, fmt.Errorf("too many environment keys: %d > %d", len(env.Data), MaxEnvironmentKeys)
+	maxKeys := options.MaxKeys
+	if maxKeys <= 0 {
+		maxKeys = MaxEnvironmentKeys
+	}
+	if len(env.Data) > maxKeys {
+		return fmt.Errorf("too many environment keys: %d > %d", len(env.Data), maxKeys)
 	}
 
-	return env, nil
+	return nil
 }
 
 // loadFromSource loads configuration from a single source.
-func (c *Client) loadFromSource(ctx context.Context, source string, env *Environment, strategy MergeStrategy) error {
+// Failures are returned as a *LoadError identifying the source and provider.
+func (c *Client) loadFromSource(
+	ctx context.Context, step sourceStep, env *Environment, options LoadOptions,
+) (err error) {
+	source := c.rewriteSource(step.source)
+	ctx = ensureRequestID(ctx)
+	requestID := RequestIDFromContext(ctx)
+
 	// Parse source to determine provider
 	providerName, actualSource := c.parseSource(source)
+	defer func() {
+		if err != nil {
+			err = &LoadError{Source: step.source, Provider: providerName, Err: err}
+		}
+	}()
 
 	// Get provider
 	provider, exists := c.providers[providerName]
@@ -183,29 +609,70 @@ func (c *Client) loadFromSource(ctx context.Context, source string, env *Environ
 		return fmt.Errorf("provider %s not found", providerName)
 	}
 
-	// Validate source
+	// Validate key allowlist
+	if err := validatePatterns(step.onlyKeys); err != nil {
+		return fmt.Errorf("invalid key allowlist for %s: %w", source, err)
+	}
+
+	// Validate source, skipping optional sources that do not exist
 	if validateErr := provider.Validate(actualSource); validateErr != nil {
+		if step.optional && errors.Is(validateErr, fs.ErrNotExist) {
+			c.logger.Debugf("[%s] skipping optional source %s: not found", requestID, source)
+			return nil
+		}
 		return fmt.Errorf("source validation failed for %s: %w", source, validateErr)
 	}
 
-	// Load configuration
-	config, err := provider.Load(ctx, actualSource)
+	// Check for keys defined more than once in this source
+	if err := checkDuplicates(provider, actualSource, source, options.OnDuplicateInSource, env); err != nil {
+		return err
+	}
+
+	// Read inline directives such as @required
+	if err := applyDirectives(provider, actualSource, source, options.KeyCase, env); err != nil {
+		return err
+	}
+
+	// Load configuration, bounded by the provider's timeout when one is set
+	c.logger.Debugf("[%s] loading source %s with provider %s", requestID, source, providerName)
+	loadCtx, cancel := c.withProviderTimeout(ctx, providerName, provider)
+	config, err := provider.Load(loadCtx, actualSource)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to load from provider %s (request %s): %w", providerName, requestID, err)
+	}
+
+	// Normalize keys
+	config, err = normalizeKeys(config, options.KeyCase, step.strategy)
 	if err != nil {
-		return fmt.Errorf("failed to load from provider %s: %w", providerName, err)
+		return fmt.Errorf("key normalization failed: %w", err)
 	}
 
+	// Keep only the allowed keys of this source
+	keepMatching(config, step.onlyKeys)
+
 	// Merge configuration
 	originalSize := len(env.Data)
-	if err := c.mergeConfiguration(env.Data, config, strategy); err != nil {
+	if step.strategy == MergeStrategyPriority {
+		c.mergeByPriority(env, config, c.providerPriority(providerName), source)
+	} else if err := c.mergeConfiguration(env, config, step.strategy, source); err != nil {
 		return err
 	}
 
+	// Read comments documenting the merged keys
+	if options.PreserveComments {
+		if err := applyComments(provider, actualSource, source, options.KeyCase, env); err != nil {
+			return err
+		}
+	}
+
 	// Add source info
 	env.Sources = append(env.Sources, SourceInfo{
 		Name:     source,
 		Provider: providerName,
 		KeyCount: len(env.Data) - originalSize,
 	})
+	c.logger.Debugf("[%s] loaded %d keys from source %s", requestID, len(config), source)
 
 	return nil
 }
@@ -223,26 +690,120 @@ func (c *Client) parseSource(source string) (providerName, sourcePath string) {
 }
 
 // mergeConfiguration merges configuration based on the merge strategy.
-func (c *Client) mergeConfiguration(target, source map[string]string, strategy MergeStrategy) error {
-	for key, value := range source {
+// Conflicts are logged by key only, since values may contain secrets.
+// The source of every value written is recorded for Environment.Provenance. Keys are
+// written in sorted order, so the write order used to resolve later collisions between
+// keys of one source is deterministic. When MergeStrategyError or MergeStrategyErrorOnConflict
+// rejects the source, a *MergeConflictError lists every offending key and nothing is written.
+func (c *Client) mergeConfiguration(
+	env *Environment, source map[string]string, strategy MergeStrategy, sourceName string,
+) error {
+	target := env.Data
+
+	// Reject the whole source when the strategy forbids any of its keys
+	if strategy == MergeStrategyError || strategy == MergeStrategyErrorOnConflict {
+		var rejected []string
+		for _, key := range sortedKeys(source) {
+			existingValue, exists := target[key]
+			if exists && (strategy == MergeStrategyError || existingValue != source[key]) {
+				rejected = append(rejected, key)
+			}
+		}
+		if len(rejected) > 0 {
+			return &MergeConflictError{Strategy: strategy, Source: sourceName, Keys: rejected}
+		}
+	}
+
+	for _, key := range sortedKeys(source) {
+		value := source[key]
 		if existingValue, exists := target[key]; exists {
+			env.recordContribution(key, value, sourceName)
+
 			switch strategy {
-			case MergeStrategyError:
-				return fmt.Errorf("duplicate key found: %s (existing: %s, new: %s)", key, existingValue, value)
+			case MergeStrategyError, MergeStrategyErrorOnConflict:
+				// Only identical values of MergeStrategyErrorOnConflict get here
 			case MergeStrategyPreserve:
 				// Keep existing value, skip new one
+				c.logger.Debugf("keeping existing value of %s, ignoring value from %s", key, sourceName)
 				continue
-			case MergeStrategyOverride:
+			case MergeStrategyOverride, MergeStrategyPriority:
 				// Override with new value (default behavior)
+				if existingValue != value {
+					c.logger.Debugf("overriding %s with value from %s", key, sourceName)
+				}
 			}
 		}
 
 		target[key] = value
+		env.setProvenance(key, sourceName)
 	}
 
 	return nil
 }
 
+// mergeByPriority merges configuration, keeping existing values set by a provider
+// with a higher precedence (lower priority number) than the incoming one.
+func (c *Client) mergeByPriority(env *Environment, source map[string]string, priority int, sourceName string) {
+	if env.keyPriorities == nil {
+		env.keyPriorities = make(map[string]int, len(source))
+	}
+
+	for _, key := range sortedKeys(source) {
+		value := source[key]
+		if _, exists := env.Data[key]; exists {
+			env.recordContribution(key, value, sourceName)
+		}
+
+		if existing, exists := env.keyPriorities[key]; exists && existing < priority {
+			c.logger.Debugf("keeping %s from higher-priority provider, ignoring value from %s", key, sourceName)
+			continue
+		}
+
+		env.Data[key] = value
+		env.keyPriorities[key] = priority
+		env.setProvenance(key, sourceName)
+	}
+}
+
+// sortedKeys returns the keys of data in sorted order.
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// addWarning records a non-fatal issue and logs it.
+func (e *Environment) addWarning(format string, args ...interface{}) {
+	warning := fmt.Sprintf(format, args...)
+	e.Warnings = append(e.Warnings, warning)
+	e.client.logger.Warnf("%s", warning)
+}
+
+// Provenance returns the source that set the final value of a key during the load.
+// Keys injected from LoadOptions.Defaults report DefaultsSource. It returns false for
+// keys not present in the environment or not set by the load.
+func (e *Environment) Provenance(key string) (string, bool) {
+	if _, exists := e.Data[key]; !exists {
+		return "", false
+	}
+	source, exists := e.provenance[key]
+	return source, exists
+}
+
+// setProvenance records the source that set a key.
+func (e *Environment) setProvenance(key, source string) {
+	if e.provenance == nil {
+		e.provenance = make(map[string]string)
+		e.writeOrder = make(map[string]int)
+	}
+	e.provenance[key] = source
+	e.writes++
+	e.writeOrder[key] = e.writes
+}
+
 // Keys returns the list of configuration keys.
 func (e *Environment) Keys() []string {
 	keys := make([]string, 0, len(e.Data))
@@ -272,6 +833,37 @@ func (e *Environment) Export(ctx context.Context, destination string) error {
 	return e.client.exporter.Export(ctx, e.Data, destination)
 }
 
+// ExportChanged exports the environment and reports whether the destination was written.
+// Exporters that do not implement ChangeReportingExporter always report a write.
+func (e *Environment) ExportChanged(ctx context.Context, destination string) (bool, error) {
+	if e.client.exporter == nil {
+		return false, fmt.Errorf("no exporter configured")
+	}
+
+	if reporting, ok := e.client.exporter.(ChangeReportingExporter); ok {
+		return reporting.ExportChanged(ctx, e.Data, destination)
+	}
+
+	if err := e.client.exporter.Export(ctx, e.Data, destination); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Render serializes the environment in the given format, such as "json" or "yaml", and returns
+// the content without writing a file. The configured exporter must implement Renderer.
+func (e *Environment) Render(format string) ([]byte, error) {
+	if e.client.exporter == nil {
+		return nil, fmt.Errorf("no exporter configured")
+	}
+
+	renderer, ok := e.client.exporter.(Renderer)
+	if !ok {
+		return nil, fmt.Errorf("exporter does not support rendering")
+	}
+	return renderer.Render(e.Data, format)
+}
+
 // ExportEnv exports the environment to the specified destination.
 // This method is kept for backward compatibility.
 func (e *Environment) ExportEnv(destination string) error {
@@ -287,3 +879,75 @@ func (e *Environment) Size() int {
 func (e *Environment) IsEmpty() bool {
 	return len(e.Data) == 0
 }
+
+// Diff compares this environment with another and returns the differences.
+// Keys only in other are Added, keys only in e are Removed. Neither environment is modified;
+// a nil environment is treated as empty.
+func (e *Environment) Diff(other *Environment) Diff {
+	diff := Diff{
+		Added:   make(map[string]string),
+		Removed: make(map[string]string),
+		Changed: make(map[string][2]string),
+	}
+
+	var before, after map[string]string
+	if e != nil {
+		before = e.Data
+	}
+	if other != nil {
+		after = other.Data
+	}
+
+	for key, oldValue := range before {
+		newValue, exists := after[key]
+		switch {
+		case !exists:
+			diff.Removed[key] = oldValue
+		case newValue != oldValue:
+			diff.Changed[key] = [2]string{oldValue, newValue}
+		}
+	}
+
+	for key, newValue := range after {
+		if _, exists := before[key]; !exists {
+			diff.Added[key] = newValue
+		}
+	}
+
+	return diff
+}
+
+// IsEmpty returns true if the diff contains no differences.
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String returns a human-readable representation of the diff, one key per line:
+// "+ KEY=value" for added, "- KEY=value" for removed and "~ KEY: old -> new" for changed keys.
+func (d Diff) String() string {
+	keys := make([]string, 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+	for key := range d.Added {
+		keys = append(keys, key)
+	}
+	for key := range d.Removed {
+		keys = append(keys, key)
+	}
+	for key := range d.Changed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	for _, key := range keys {
+		if value, exists := d.Added[key]; exists {
+			builder.WriteString(fmt.Sprintf("+ %s=%s\n", key, value))
+		} else if value, exists := d.Removed[key]; exists {
+			builder.WriteString(fmt.Sprintf("- %s=%s\n", key, value))
+		} else {
+			values := d.Changed[key]
+			builder.WriteString(fmt.Sprintf("~ %s: %s -> %s\n", key, values[0], values[1]))
+		}
+	}
+
+	return builder.String()
+}
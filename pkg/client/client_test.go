@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// mapProvider serves sources from memory and fails sources without data.
+type mapProvider struct {
+	sources map[string]map[string]string
+}
+
+// Name returns the provider name.
+func (p *mapProvider) Name() string {
+	return "map"
+}
+
+// Load returns a copy of the source data.
+func (p *mapProvider) Load(_ context.Context, source string) (map[string]string, error) {
+	data, exists := p.sources[source]
+	if !exists {
+		return nil, fmt.Errorf("source %s not found", source)
+	}
+
+	config := make(map[string]string, len(data))
+	for key, value := range data {
+		config[key] = value
+	}
+	return config, nil
+}
+
+// Validate accepts every source.
+func (p *mapProvider) Validate(_ string) error {
+	return nil
+}
+
+// annotatedProvider is a mapProvider that also reads directives and comments, failing to
+// read the comments of sources listed in failComments.
+type annotatedProvider struct {
+	mapProvider
+	directives   map[string][]Directive
+	failComments map[string]bool
+}
+
+// ReadDirectives returns the directives of the source.
+func (p *annotatedProvider) ReadDirectives(source string) ([]Directive, error) {
+	return p.directives[source], nil
+}
+
+// ReadComments documents every key of the source, or fails for sources in failComments.
+func (p *annotatedProvider) ReadComments(source string) (map[string]string, error) {
+	if p.failComments[source] {
+		return nil, fmt.Errorf("cannot read comments of %s", source)
+	}
+
+	comments := make(map[string]string)
+	for key := range p.sources[source] {
+		comments[key] = "documents " + key
+	}
+	return comments, nil
+}
+
+// newMapClient returns a client with sources served by a mapProvider as the default provider.
+func newMapClient(sources map[string]map[string]string) *Client {
+	c := New()
+	c.AddProvider(DefaultProviderName, &mapProvider{sources: sources})
+	return c
+}
+
+func TestLoadMergeConflictError(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"base": {"A": "1", "B": "2", "C": "3"},
+		"prod": {"A": "1", "B": "5", "C": "6", "D": "7"},
+	})
+
+	tests := []struct {
+		strategy MergeStrategy
+		keys     []string
+	}{
+		{MergeStrategyError, []string{"A", "B", "C"}},
+		{MergeStrategyErrorOnConflict, []string{"B", "C"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy.String(), func(t *testing.T) {
+			_, err := c.Load(context.Background(), LoadOptions{
+				Sources:       []string{"base", "prod"},
+				MergeStrategy: tt.strategy,
+			})
+
+			var conflictErr *MergeConflictError
+			if !errors.As(err, &conflictErr) {
+				t.Fatalf("Load() error = %v, want *MergeConflictError", err)
+			}
+			if conflictErr.Source != "prod" || !reflect.DeepEqual(conflictErr.Keys, tt.keys) {
+				t.Errorf("MergeConflictError = %+v, want source prod and keys %v", conflictErr, tt.keys)
+			}
+		})
+	}
+}
+
+func TestLoadErrorKeepsOriginalSource(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{})
+	c.SetSourceRewriter(func(source string) string {
+		return source + ".prod"
+	})
+
+	_, err := c.Load(context.Background(), LoadOptions{Sources: []string{"app"}})
+
+	var loadErr *LoadError
+	if !errors.As(err, &loadErr) {
+		t.Fatalf("Load() error = %v, want *LoadError", err)
+	}
+	if loadErr.Source != "app" || loadErr.Provider != DefaultProviderName {
+		t.Errorf("LoadError = %+v, want source app and provider %s", loadErr, DefaultProviderName)
+	}
+}
+
+func TestLoadPromptMissing(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{"app": {"HOST": "db"}})
+
+	env, err := c.Load(context.Background(), LoadOptions{
+		Sources:      []string{"app"},
+		RequiredKeys: []string{"HOST", "API_KEY"},
+		PromptMissing: func(ctx context.Context, key string) (string, error) {
+			if RequestIDFromContext(ctx) == "" {
+				t.Error("PromptMissing() context carries no request ID")
+			}
+			return "typed-" + key, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if env.Data["API_KEY"] != "typed-API_KEY" || !reflect.DeepEqual(env.PromptedKeys, []string{"API_KEY"}) {
+		t.Errorf("Load() = %v with prompted keys %v, want API_KEY prompted", env.Data, env.PromptedKeys)
+	}
+	if source, _ := env.Provenance("API_KEY"); source != PromptSource {
+		t.Errorf("Provenance(API_KEY) = %q, want %q", source, PromptSource)
+	}
+}
+
+func TestLoadPromptMissingHonorsDeadline(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{"app": {}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := c.Load(ctx, LoadOptions{
+		Sources:      []string{"app"},
+		RequiredKeys: []string{"API_KEY"},
+		PromptMissing: func(ctx context.Context, _ string) (string, error) {
+			<-ctx.Done()
+			return "", ctx.Err()
+		},
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Load() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestLoadMergeStrategies(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"base": {"HOST": "localhost", "PORT": "5432"},
+		"prod": {"HOST": "db.internal", "USER": "app"},
+	})
+
+	tests := []struct {
+		strategy MergeStrategy
+		host     string
+		winner   string
+	}{
+		{MergeStrategyOverride, "db.internal", "prod"},
+		{MergeStrategyPreserve, "localhost", "base"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy.String(), func(t *testing.T) {
+			env, err := c.Load(context.Background(), LoadOptions{
+				Sources:       []string{"base", "prod"},
+				MergeStrategy: tt.strategy,
+			})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if env.Size() != 3 || env.Data["HOST"] != tt.host {
+				t.Errorf("Load() = %v, want 3 keys with HOST=%s", env.Data, tt.host)
+			}
+			if source, _ := env.Provenance("HOST"); source != tt.winner {
+				t.Errorf("Provenance(HOST) = %q, want %q", source, tt.winner)
+			}
+			if len(env.MergeReport.Conflicts) != 1 || env.MergeReport.Conflicts[0].Winner != tt.winner {
+				t.Errorf("MergeReport = %+v, want HOST won by %s", env.MergeReport, tt.winner)
+			}
+			if len(env.Sources) != 2 || env.Sources[1].KeyCount != 1 {
+				t.Errorf("Sources = %+v, want prod adding 1 key", env.Sources)
+			}
+		})
+	}
+}
+
+func TestLoadSourceSpecs(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"base":    {"HOST": "localhost", "DB_USER": "app"},
+		"secrets": {"HOST": "ignored", "DB_PASSWORD": "secret", "DB_USER": "admin"},
+	})
+	preserve := MergeStrategyPreserve
+
+	env, err := c.Load(context.Background(), LoadOptions{
+		Sources:     []string{"base"},
+		SourceSpecs: []SourceSpec{{Source: "secrets", Strategy: &preserve, OnlyKeys: []string{"DB_*"}}},
+	})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	want := map[string]string{"HOST": "localhost", "DB_USER": "app", "DB_PASSWORD": "secret"}
+	if !reflect.DeepEqual(env.Data, want) {
+		t.Errorf("Load() = %v, want %v", env.Data, want)
+	}
+}
+
+func TestLoadContinueOnErrorDiscardsFailedSources(t *testing.T) {
+	provider := &annotatedProvider{
+		mapProvider: mapProvider{sources: map[string]map[string]string{
+			"base":    {"HOST": "localhost"},
+			"partial": {"HOST": "partial", "EXTRA": "partial"},
+		}},
+		directives: map[string][]Directive{
+			"broken": {{Name: DirectiveRequired, Args: []string{"SECRET"}, Line: 1}},
+		},
+		failComments: map[string]bool{"partial": true},
+	}
+	c := New()
+	c.AddProvider(DefaultProviderName, provider)
+
+	env, err := c.Load(context.Background(), LoadOptions{
+		Sources:          []string{"base", "partial", "broken"},
+		ContinueOnError:  true,
+		PreserveComments: true,
+	})
+	if env == nil {
+		t.Fatalf("Load() returned no environment, error = %v", err)
+	}
+
+	// Both failures are reported
+	var loadErr *LoadError
+	if !errors.As(err, &loadErr) || loadErr.Source != "partial" {
+		t.Errorf("Load() error = %v, want the *LoadError of partial first", err)
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 2 {
+		t.Errorf("Load() error = %v, want 2 joined source errors", err)
+	}
+
+	// Nothing of the failed sources is left: keys, conflicts, comments or required keys
+	if want := map[string]string{"HOST": "localhost"}; !reflect.DeepEqual(env.Data, want) {
+		t.Errorf("Load() = %v, want %v", env.Data, want)
+	}
+	if source, _ := env.Provenance("HOST"); source != "base" {
+		t.Errorf("Provenance(HOST) = %q, want base", source)
+	}
+	if len(env.MergeReport.Conflicts) != 0 {
+		t.Errorf("MergeReport = %+v, want no conflicts", env.MergeReport)
+	}
+	if len(env.Sources) != 1 || env.Comments["HOST"] != "documents HOST" || len(env.Comments) != 1 {
+		t.Errorf("Sources = %+v, Comments = %v; want base only", env.Sources, env.Comments)
+	}
+	if len(env.Warnings) != 2 {
+		t.Errorf("Warnings = %v, want one per failed source", env.Warnings)
+	}
+}
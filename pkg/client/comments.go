@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+)
+
+// CommentReader is an optional interface for providers that can read the comments
+// documenting keys of a source. Client.Load reads them when LoadOptions.PreserveComments is set.
+type CommentReader interface {
+	// ReadComments returns the comment preceding each key of the source, keyed by the
+	// key it documents. Multi-line comments are joined with newlines.
+	ReadComments(source string) (map[string]string, error)
+}
+
+// applyComments records the comments of a source if its provider supports them. Only the
+// comments of keys whose final value came from this source are kept, so a comment always
+// describes the value it is exported with.
+func applyComments(provider Provider, source, displaySource string, keyCase KeyCase, env *Environment) error {
+	reader, ok := provider.(CommentReader)
+	if !ok {
+		return nil
+	}
+
+	comments, err := reader.ReadComments(source)
+	if err != nil {
+		return fmt.Errorf("comment parsing failed: %w", err)
+	}
+
+	for key, comment := range comments {
+		key = NormalizeKey(key, keyCase)
+		if env.provenance[key] != displaySource {
+			continue
+		}
+
+		if env.Comments == nil {
+			env.Comments = make(map[string]string)
+		}
+		env.Comments[key] = comment
+	}
+
+	return nil
+}
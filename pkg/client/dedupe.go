@@ -0,0 +1,114 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CaseCollision describes keys that differ only by case and were collapsed into one.
+type CaseCollision struct {
+	// Key is the canonical key the collision was collapsed into.
+	Key string
+
+	// Keys are the colliding keys, in sorted order.
+	Keys []string
+
+	// Winner is the colliding key whose value was kept.
+	Winner string
+}
+
+// dedupeCase collapses keys that differ only by case into their canonical form, keeping
+// the value the merge strategy would have kept had the keys been equal: the last written
+// for MergeStrategyOverride, the first for MergeStrategyPreserve and the highest-priority
+// provider's for MergeStrategyPriority. MergeStrategyError fails on any collision and
+// MergeStrategyErrorOnConflict on collisions with different values. Keys without a
+// collision are left unchanged.
+func (c *Client) dedupeCase(env *Environment, keyCase KeyCase, strategy MergeStrategy) error {
+	if keyCase == KeyCaseNone {
+		return nil
+	}
+	if keyCase != KeyCaseUpper && keyCase != KeyCaseLower {
+		return fmt.Errorf("unsupported dedupe case: %s (valid: upper, lower)", keyCase)
+	}
+
+	// Group keys case-insensitively
+	groups := make(map[string][]string)
+	for key := range env.Data {
+		folded := strings.ToLower(key)
+		groups[folded] = append(groups[folded], key)
+	}
+
+	folds := make([]string, 0, len(groups))
+	for folded, keys := range groups {
+		if len(keys) > 1 {
+			folds = append(folds, folded)
+		}
+	}
+	sort.Strings(folds)
+
+	for _, folded := range folds {
+		keys := groups[folded]
+		sort.Strings(keys)
+
+		winner, err := env.collisionWinner(keys, strategy, "differ only by case")
+		if err != nil {
+			return err
+		}
+
+		// Replace the colliding keys with the canonical key
+		canonical := NormalizeKey(winner, keyCase)
+		value := env.Data[winner]
+		source, hasSource := env.provenance[winner]
+		comment, hasComment := env.Comments[winner]
+		for _, key := range keys {
+			delete(env.Data, key)
+			delete(env.provenance, key)
+			delete(env.Comments, key)
+		}
+		env.Data[canonical] = value
+		if hasSource {
+			env.setProvenance(canonical, source)
+		}
+		if hasComment {
+			env.Comments[canonical] = comment
+		}
+
+		env.CaseCollisions = append(env.CaseCollisions, CaseCollision{Key: canonical, Keys: keys, Winner: winner})
+		c.logger.Debugf("collapsed keys %s into %s, keeping the value of %s",
+			strings.Join(keys, ", "), canonical, winner)
+	}
+
+	return nil
+}
+
+// collisionWinner picks the colliding key whose value the merge strategy keeps. Errors describe
+// the keys with what, such as "differ only by case".
+func (e *Environment) collisionWinner(keys []string, strategy MergeStrategy, what string) (string, error) {
+	winner := keys[0]
+	for _, key := range keys[1:] {
+		switch strategy {
+		case MergeStrategyError:
+			return "", fmt.Errorf("keys %s %s", strings.Join(keys, ", "), what)
+		case MergeStrategyErrorOnConflict:
+			if e.Data[key] != e.Data[winner] {
+				return "", fmt.Errorf("keys %s %s and have different values", strings.Join(keys, ", "), what)
+			}
+		case MergeStrategyPreserve:
+			if e.writeOrder[key] < e.writeOrder[winner] {
+				winner = key
+			}
+		case MergeStrategyPriority:
+			priority, winnerPriority := e.keyPriorities[key], e.keyPriorities[winner]
+			if priority < winnerPriority || (priority == winnerPriority && e.writeOrder[key] > e.writeOrder[winner]) {
+				winner = key
+			}
+		case MergeStrategyOverride:
+			if e.writeOrder[key] > e.writeOrder[winner] {
+				winner = key
+			}
+		}
+	}
+
+	return winner, nil
+}
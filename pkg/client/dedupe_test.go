@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadDedupeCase(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"base":  {"Path": "/usr/bin", "HOME": "/root"},
+		"local": {"PATH": "/opt/bin"},
+	})
+
+	tests := []struct {
+		name     string
+		keyCase  KeyCase
+		strategy MergeStrategy
+		want     map[string]string
+		winner   string
+	}{
+		{"override upper", KeyCaseUpper, MergeStrategyOverride,
+			map[string]string{"PATH": "/opt/bin", "HOME": "/root"}, "PATH"},
+		{"preserve upper", KeyCaseUpper, MergeStrategyPreserve,
+			map[string]string{"PATH": "/usr/bin", "HOME": "/root"}, "Path"},
+		{"override lower", KeyCaseLower, MergeStrategyOverride,
+			map[string]string{"path": "/opt/bin", "HOME": "/root"}, "PATH"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, err := c.Load(context.Background(), LoadOptions{
+				Sources:       []string{"base", "local"},
+				MergeStrategy: tt.strategy,
+				DedupeCase:    tt.keyCase,
+			})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(env.Data, tt.want) {
+				t.Errorf("Load() = %v, want %v", env.Data, tt.want)
+			}
+			if len(env.CaseCollisions) != 1 || env.CaseCollisions[0].Winner != tt.winner ||
+				!reflect.DeepEqual(env.CaseCollisions[0].Keys, []string{"PATH", "Path"}) {
+				t.Errorf("CaseCollisions = %+v, want PATH and Path won by %s", env.CaseCollisions, tt.winner)
+			}
+		})
+	}
+}
+
+func TestLoadDedupeCaseErrors(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"different": {"Path": "/usr/bin", "PATH": "/opt/bin"},
+		"identical": {"Path": "/usr/bin", "PATH": "/usr/bin"},
+	})
+
+	// Identical values collapse under the conflict strategy, different ones fail
+	env, err := c.Load(context.Background(), LoadOptions{
+		Sources:       []string{"identical"},
+		MergeStrategy: MergeStrategyErrorOnConflict,
+		DedupeCase:    KeyCaseUpper,
+	})
+	if err != nil || env.Data["PATH"] != "/usr/bin" || env.Size() != 1 {
+		t.Errorf("Load() of identical values = %v, %v; want PATH=/usr/bin", env, err)
+	}
+
+	for _, strategy := range []MergeStrategy{MergeStrategyError, MergeStrategyErrorOnConflict} {
+		_, err := c.Load(context.Background(), LoadOptions{
+			Sources:       []string{"different"},
+			MergeStrategy: strategy,
+			DedupeCase:    KeyCaseUpper,
+		})
+		if err == nil || !strings.Contains(err.Error(), "differ only by case") {
+			t.Errorf("Load() with %s error = %v, want keys differing only by case", strategy, err)
+		}
+	}
+
+	if _, err := c.Load(context.Background(), LoadOptions{
+		Sources:    []string{"identical"},
+		DedupeCase: KeyCaseSnake,
+	}); err == nil {
+		t.Error("Load() with snake dedupe case succeeded, want error")
+	}
+}
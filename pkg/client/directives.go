@@ -0,0 +1,84 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Constants for source directives
+const (
+	// DirectiveRequired declares keys that must be present after all sources are merged,
+	// as in "# @required DATABASE_URL, API_KEY".
+	DirectiveRequired = "required"
+)
+
+// Directive is an inline instruction declared in a source, such as a "# @required" comment.
+type Directive struct {
+	// Name is the directive name without the @ prefix.
+	Name string
+
+	// Args are the directive arguments.
+	Args []string
+
+	// Line is the line number of the directive in the source.
+	Line int
+}
+
+// DirectiveReader is an optional interface for providers that can read inline directives
+// from a source. Client.Load enforces known directives and warns about unknown ones.
+type DirectiveReader interface {
+	// ReadDirectives returns the directives declared in the source.
+	ReadDirectives(source string) ([]Directive, error)
+}
+
+// applyDirectives records the directives of a source if its provider supports them.
+// Required keys are normalized with the load's key case so they match the merged keys.
+func applyDirectives(provider Provider, source, displaySource string, keyCase KeyCase, env *Environment) error {
+	reader, ok := provider.(DirectiveReader)
+	if !ok {
+		return nil
+	}
+
+	directives, err := reader.ReadDirectives(source)
+	if err != nil {
+		return fmt.Errorf("directive parsing failed: %w", err)
+	}
+
+	for _, directive := range directives {
+		if directive.Name != DirectiveRequired {
+			env.addWarning("%s: ignoring unknown directive @%s at line %d", displaySource, directive.Name, directive.Line)
+			continue
+		}
+
+		if env.requiredKeys == nil {
+			env.requiredKeys = make(map[string]string)
+		}
+		for _, key := range directive.Args {
+			key = NormalizeKey(key, keyCase)
+			if _, exists := env.requiredKeys[key]; !exists {
+				env.requiredKeys[key] = fmt.Sprintf("%s:%d", displaySource, directive.Line)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkRequiredKeys fails when a key declared by a @required directive or LoadOptions.RequiredKeys
+// is missing.
+func checkRequiredKeys(env *Environment) error {
+	var missing []string
+	for key, declaredAt := range env.requiredKeys {
+		if _, exists := env.Data[key]; !exists {
+			missing = append(missing, fmt.Sprintf("%s (required by %s)", key, declaredAt))
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	return fmt.Errorf("missing required keys: %s", strings.Join(missing, ", "))
+}
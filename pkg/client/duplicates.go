@@ -0,0 +1,84 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DuplicatePolicy defines how keys defined more than once in a single source are handled.
+type DuplicatePolicy int
+
+const (
+	// DuplicateIgnore silently keeps the last definition (default).
+	DuplicateIgnore DuplicatePolicy = iota
+
+	// DuplicateWarn keeps the last definition and records a warning on the environment.
+	DuplicateWarn
+
+	// DuplicateError fails the load.
+	DuplicateError
+)
+
+// DuplicateKey describes a key defined more than once within a single source.
+type DuplicateKey struct {
+	// Key is the duplicated key.
+	Key string
+
+	// Line is the line number of the repeated definition.
+	Line int
+
+	// FirstLine is the line number of the first definition.
+	FirstLine int
+}
+
+// String returns a human-readable description of the duplicate.
+func (d DuplicateKey) String() string {
+	return fmt.Sprintf("key %s redefined at line %d (first defined at line %d)", d.Key, d.Line, d.FirstLine)
+}
+
+// DuplicateDetector is an optional interface for providers that can report keys
+// defined more than once within a single source.
+type DuplicateDetector interface {
+	// FindDuplicateKeys returns the repeated key definitions in the source.
+	FindDuplicateKeys(source string) ([]DuplicateKey, error)
+}
+
+// ParseDuplicatePolicy converts a string into a DuplicatePolicy.
+func ParseDuplicatePolicy(value string) (DuplicatePolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "ignore":
+		return DuplicateIgnore, nil
+	case "warn":
+		return DuplicateWarn, nil
+	case "error":
+		return DuplicateError, nil
+	default:
+		return DuplicateIgnore, fmt.Errorf("unknown duplicate policy: %s (valid: ignore, warn, error)", value)
+	}
+}
+
+// checkDuplicates applies the duplicate policy to a source if its provider supports detection.
+func checkDuplicates(provider Provider, source, displaySource string, policy DuplicatePolicy, env *Environment) error {
+	if policy == DuplicateIgnore {
+		return nil
+	}
+
+	detector, ok := provider.(DuplicateDetector)
+	if !ok {
+		return nil
+	}
+
+	duplicates, err := detector.FindDuplicateKeys(source)
+	if err != nil {
+		return fmt.Errorf("duplicate detection failed: %w", err)
+	}
+
+	for _, duplicate := range duplicates {
+		if policy == DuplicateError {
+			return fmt.Errorf("duplicate %s", duplicate)
+		}
+		env.addWarning("%s: duplicate %s", displaySource, duplicate)
+	}
+
+	return nil
+}
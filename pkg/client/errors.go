@@ -0,0 +1,53 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LoadError reports a failure to load a single source. Use errors.As to recover the
+// source and provider, and errors.Is to match provider-specific sentinel errors.
+type LoadError struct {
+	// Source is the source string including an optional provider prefix, as passed to
+	// Load and before any SetSourceRewriter rewrite.
+	Source string
+
+	// Provider is the name of the provider resolving the source.
+	Provider string
+
+	// Err is the underlying error. Its message names the source after rewriting.
+	Err error
+}
+
+// Error returns the error message.
+func (e *LoadError) Error() string {
+	return fmt.Sprintf("failed to load from source %s: %v", e.Source, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
+// MergeConflictError reports the keys of a source rejected by MergeStrategyError or
+// MergeStrategyErrorOnConflict. Keys are listed without values, since values may contain
+// secrets.
+type MergeConflictError struct {
+	// Strategy is the merge strategy that rejected the source.
+	Strategy MergeStrategy
+
+	// Source is the source whose keys were rejected.
+	Source string
+
+	// Keys lists the duplicate or conflicting keys, in sorted order.
+	Keys []string
+}
+
+// Error returns the error message.
+func (e *MergeConflictError) Error() string {
+	kind := "duplicate"
+	if e.Strategy == MergeStrategyErrorOnConflict {
+		kind = "conflicting"
+	}
+	return fmt.Sprintf("%d %s keys in %s: %s", len(e.Keys), kind, e.Source, strings.Join(e.Keys, ", "))
+}
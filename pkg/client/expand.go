@@ -0,0 +1,56 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+)
+
+// osEnvReference matches ${env:NAME} references and bare $NAME references.
+var osEnvReference = regexp.MustCompile(`\$\{env:([A-Za-z_][A-Za-z0-9_]*)\}|\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// expandOSEnv resolves ${env:NAME} references (and bare $NAME references when bare is true)
+// in every value against the process environment. Undefined variables cause an error when
+// strict is true and expand to an empty string otherwise. It returns the keys whose values changed.
+func expandOSEnv(data map[string]string, bare, strict bool) ([]string, error) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var expanded []string
+	for _, key := range keys {
+		value := data[key]
+
+		var expandErr error
+		result := osEnvReference.ReplaceAllStringFunc(value, func(match string) string {
+			groups := osEnvReference.FindStringSubmatch(match)
+			name := groups[1]
+			if name == "" {
+				if !bare {
+					return match
+				}
+				name = groups[2]
+			}
+
+			resolved, exists := os.LookupEnv(name)
+			if !exists && strict && expandErr == nil {
+				expandErr = fmt.Errorf("undefined OS environment variable %s referenced by key %s", name, key)
+			}
+			return resolved
+		})
+
+		if expandErr != nil {
+			return nil, expandErr
+		}
+
+		if result != value {
+			data[key] = result
+			expanded = append(expanded, key)
+		}
+	}
+
+	return expanded, nil
+}
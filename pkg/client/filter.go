@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+	"path"
+)
+
+// Filter keeps only keys matching at least one include pattern (all keys when include
+// is empty), then removes keys matching any exclude pattern. Patterns use path.Match
+// semantics and are matched case-sensitively against keys.
+func (e *Environment) Filter(include, exclude []string) error {
+	if err := validatePatterns(include); err != nil {
+		return err
+	}
+	if err := validatePatterns(exclude); err != nil {
+		return err
+	}
+
+	for key := range e.Data {
+		if len(include) > 0 && !matchAny(include, key) {
+			delete(e.Data, key)
+			continue
+		}
+
+		if matchAny(exclude, key) {
+			delete(e.Data, key)
+		}
+	}
+
+	return nil
+}
+
+// validatePatterns checks that every glob pattern is well-formed.
+func validatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid key pattern %s: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchAny reports whether the key matches any of the glob patterns.
+func matchAny(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// keepMatching deletes the keys of config matching none of the patterns. An empty pattern
+// list keeps every key.
+func keepMatching(config map[string]string, patterns []string) {
+	if len(patterns) == 0 {
+		return
+	}
+
+	for key := range config {
+		if !matchAny(patterns, key) {
+			delete(config, key)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// KeyCase defines how keys are normalized after each source is loaded.
+type KeyCase string
+
+const (
+	// KeyCaseNone leaves keys unchanged.
+	KeyCaseNone KeyCase = ""
+
+	// KeyCaseUpper converts keys to upper case.
+	KeyCaseUpper KeyCase = "upper"
+
+	// KeyCaseLower converts keys to lower case.
+	KeyCaseLower KeyCase = "lower"
+
+	// KeyCaseSnake converts keys to SCREAMING_SNAKE_CASE, inserting underscores at case boundaries.
+	KeyCaseSnake KeyCase = "snake"
+)
+
+// ParseKeyCase converts a string into a KeyCase.
+func ParseKeyCase(value string) (KeyCase, error) {
+	switch KeyCase(strings.ToLower(strings.TrimSpace(value))) {
+	case KeyCaseNone:
+		return KeyCaseNone, nil
+	case KeyCaseUpper:
+		return KeyCaseUpper, nil
+	case KeyCaseLower:
+		return KeyCaseLower, nil
+	case KeyCaseSnake:
+		return KeyCaseSnake, nil
+	default:
+		return KeyCaseNone, fmt.Errorf("unknown key case: %s (valid: upper, lower, snake)", value)
+	}
+}
+
+// NormalizeKey converts a single key to the requested case.
+func NormalizeKey(key string, keyCase KeyCase) string {
+	switch keyCase {
+	case KeyCaseUpper:
+		return strings.ToUpper(key)
+	case KeyCaseLower:
+		return strings.ToLower(key)
+	case KeyCaseSnake:
+		return toScreamingSnake(key)
+	default:
+		return key
+	}
+}
+
+// normalizeKeys returns a copy of config with all keys normalized.
+// Keys are processed in sorted order so collisions resolve deterministically:
+// MergeStrategyError fails, MergeStrategyErrorOnConflict fails only if the values differ,
+// MergeStrategyPreserve keeps the first key in sorted order, and MergeStrategyOverride keeps the last.
+func normalizeKeys(config map[string]string, keyCase KeyCase, strategy MergeStrategy) (map[string]string, error) {
+	if keyCase == KeyCaseNone {
+		return config, nil
+	}
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]string, len(config))
+	origins := make(map[string]string, len(config))
+	for _, key := range keys {
+		newKey := NormalizeKey(key, keyCase)
+		if origin, exists := origins[newKey]; exists {
+			switch strategy {
+			case MergeStrategyError:
+				return nil, fmt.Errorf("keys %s and %s both normalize to %s", origin, key, newKey)
+			case MergeStrategyErrorOnConflict:
+				if config[origin] != config[key] {
+					return nil, fmt.Errorf("keys %s and %s both normalize to %s with different values", origin, key, newKey)
+				}
+			case MergeStrategyPreserve:
+				continue
+			case MergeStrategyOverride, MergeStrategyPriority:
+				// Override with the later key
+			}
+		}
+
+		origins[newKey] = key
+		normalized[newKey] = config[key]
+	}
+
+	return normalized, nil
+}
+
+// toScreamingSnake converts camelCase, PascalCase, kebab-case, and mixed keys to SCREAMING_SNAKE_CASE.
+func toScreamingSnake(key string) string {
+	runes := []rune(key)
+	var builder strings.Builder
+
+	for i, r := range runes {
+		if r == '-' || r == ' ' {
+			r = '_'
+		}
+
+		if i > 0 && unicode.IsUpper(r) && isCaseBoundary(runes, i) {
+			builder.WriteRune('_')
+		}
+
+		builder.WriteRune(unicode.ToUpper(r))
+	}
+
+	return builder.String()
+}
+
+// isCaseBoundary reports whether an upper-case rune at position i starts a new word.
+func isCaseBoundary(runes []rune, i int) bool {
+	prev := runes[i-1]
+	if prev == '_' || prev == '-' || prev == ' ' {
+		return false
+	}
+
+	// lowerUpper or digitUpper: "databaseUrl" -> "DATABASE_URL"
+	if unicode.IsLower(prev) || unicode.IsDigit(prev) {
+		return true
+	}
+
+	// Acronym followed by a word: "HTTPServer" -> "HTTP_SERVER"
+	return unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
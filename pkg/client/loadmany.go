@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Constants for bulk loads
+const (
+	// MaxConcurrentLoads is the number of option sets LoadMany loads at the same time.
+	MaxConcurrentLoads = 16
+)
+
+// LoadMany loads every option set concurrently, at most MaxConcurrentLoads at a time,
+// sharing the client's providers, validator and transformers. The environments are
+// returned in the order of the options, each as Load returned it: a failed load leaves a
+// nil environment, or the partial environment Load returns with LoadOptions.ContinueOnError.
+// The returned error joins the errors of the failed loads, each naming the index of its
+// option set. See Client for the thread-safety requirements.
+func (c *Client) LoadMany(ctx context.Context, options []LoadOptions) ([]*Environment, error) {
+	envs := make([]*Environment, len(options))
+	errs := make([]error, len(options))
+
+	slots := make(chan struct{}, MaxConcurrentLoads)
+	var wg sync.WaitGroup
+	for i := range options {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Wait for a free slot unless the context is done
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				errs[i] = fmt.Errorf("load %d: %w", i, ctx.Err())
+				return
+			}
+
+			env, err := c.Load(ctx, options[i])
+			envs[i] = env
+			if err != nil {
+				errs[i] = fmt.Errorf("load %d: %w", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return envs, errors.Join(errs...)
+}
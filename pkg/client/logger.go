@@ -0,0 +1,40 @@
+package client
+
+// Logger defines the minimal logging interface used by the client.
+// Implementations can adapt it to slog, zap or any other logging library.
+type Logger interface {
+	// Debugf logs detailed progress information.
+	Debugf(format string, args ...interface{})
+
+	// Infof logs general information.
+	Infof(format string, args ...interface{})
+
+	// Warnf logs non-fatal issues.
+	Warnf(format string, args ...interface{})
+}
+
+// nopLogger discards all log messages.
+type nopLogger struct{}
+
+// Debugf discards the message.
+func (nopLogger) Debugf(string, ...interface{}) {}
+
+// Infof discards the message.
+func (nopLogger) Infof(string, ...interface{}) {}
+
+// Warnf discards the message.
+func (nopLogger) Warnf(string, ...interface{}) {}
+
+// NopLogger returns a logger that discards all messages.
+func NopLogger() Logger {
+	return nopLogger{}
+}
+
+// SetLogger sets the logger used for load progress, merge conflicts and skipped sources.
+// A nil logger restores the default no-op logger.
+func (c *Client) SetLogger(logger Logger) {
+	if logger == nil {
+		logger = NopLogger()
+	}
+	c.logger = logger
+}
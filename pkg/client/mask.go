@@ -0,0 +1,143 @@
+package client
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+)
+
+// Constants for value masking
+const (
+	// MaskedValue replaces values of sensitive keys in output.
+	MaskedValue = "********"
+)
+
+// DefaultMaskPatterns are the glob patterns masked by default.
+// Patterns are matched case-insensitively against keys, except inside character classes;
+// see NewMasker.
+var DefaultMaskPatterns = []string{
+	"*PASSWORD*",
+	"*PASSWD*",
+	"*SECRET*",
+	"*TOKEN*",
+	"*KEY*",
+	"*CREDENTIAL*",
+	"*PRIVATE*",
+	"*AUTH*",
+}
+
+// Masker redacts values for keys matching glob patterns.
+// A nil Masker masks nothing.
+type Masker struct {
+	patterns []string
+	keys     map[string]bool
+}
+
+// NewMasker creates a masker for the given glob patterns using path.Match semantics.
+// Letters outside character classes match either case; character classes such as [a-z]
+// match exactly as written.
+func NewMasker(patterns ...string) (*Masker, error) {
+	normalized := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid mask pattern %s: %w", pattern, err)
+		}
+		normalized = append(normalized, caseInsensitivePattern(pattern))
+	}
+
+	return &Masker{patterns: normalized}, nil
+}
+
+// caseInsensitivePattern rewrites every letter of a valid pattern outside character classes
+// as a class of its lower and upper case, so "*token" becomes "*[tT][oO][kK][eE][nN]".
+// Character classes and escaped non-letters are copied unchanged.
+func caseInsensitivePattern(pattern string) string {
+	var builder strings.Builder
+	inClass, escaped := false, false
+
+	for _, char := range pattern {
+		lower, upper := unicode.ToLower(char), unicode.ToUpper(char)
+		switch {
+		case inClass:
+			builder.WriteRune(char)
+			if !escaped && char == ']' {
+				inClass = false
+			}
+			escaped = !escaped && char == '\\'
+		case lower != upper:
+			builder.WriteString("[" + string(lower) + string(upper) + "]")
+			escaped = false
+		case escaped:
+			builder.WriteString("\\" + string(char))
+			escaped = false
+		case char == '\\':
+			escaped = true
+		default:
+			builder.WriteRune(char)
+			inClass = char == '['
+		}
+	}
+
+	return builder.String()
+}
+
+// NewDefaultMasker creates a masker for DefaultMaskPatterns plus any extra patterns.
+func NewDefaultMasker(extra ...string) (*Masker, error) {
+	return NewMasker(append(append([]string{}, DefaultMaskPatterns...), extra...)...)
+}
+
+// AddKeys masks the keys regardless of the patterns, such as keys whose values came from
+// secret references. It does nothing on a nil Masker.
+func (m *Masker) AddKeys(keys ...string) {
+	if m == nil {
+		return
+	}
+
+	if m.keys == nil {
+		m.keys = make(map[string]bool, len(keys))
+	}
+	for _, key := range keys {
+		m.keys[key] = true
+	}
+}
+
+// ShouldMask reports whether the key matches any mask pattern or was added with AddKeys.
+func (m *Masker) ShouldMask(key string) bool {
+	if m == nil {
+		return false
+	}
+	if m.keys[key] {
+		return true
+	}
+
+	for _, pattern := range m.patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Mask returns the value, or MaskedValue if the key is sensitive.
+func (m *Masker) Mask(key, value string) string {
+	if m.ShouldMask(key) {
+		return MaskedValue
+	}
+	return value
+}
+
+// MaskMap returns a copy of config with sensitive values masked.
+func (m *Masker) MaskMap(config map[string]string) map[string]string {
+	masked := make(map[string]string, len(config))
+	for key, value := range config {
+		masked[key] = m.Mask(key, value)
+	}
+	return masked
+}
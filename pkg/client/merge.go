@@ -0,0 +1,169 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Merge merges the keys of other into the environment with the merge strategy Client.Load
+// uses between sources, and appends the sources of other to Sources. Keys keep the
+// provenance and comments recorded by other. Under MergeStrategyPriority, a key set by a
+// lower-precedence provider than the one that set it here is kept. With MergeStrategyError
+// or MergeStrategyErrorOnConflict, a conflict returns the error and leaves the environment
+// unchanged.
+func (e *Environment) Merge(other *Environment, strategy MergeStrategy) error {
+	if other == nil {
+		return fmt.Errorf("cannot merge a nil environment")
+	}
+	if e.Data == nil {
+		e.Data = make(map[string]string, len(other.Data))
+	}
+
+	c := e.client
+	if c == nil {
+		c = New()
+	}
+	groups := other.sourceGroups()
+
+	// Check for conflicts on a scratch copy, so a failed merge changes nothing
+	if strategy == MergeStrategyError || strategy == MergeStrategyErrorOnConflict {
+		scratch := &Environment{Data: make(map[string]string, len(e.Data))}
+		for key, value := range e.Data {
+			scratch.Data[key] = value
+		}
+		for _, group := range groups {
+			if err := c.mergeConfiguration(scratch, group.data, strategy, group.source); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Merge each source of other in turn
+	for _, group := range groups {
+		if strategy == MergeStrategyPriority {
+			c.mergeByPriority(e, group.data, other.keyPriority(group.data), group.source)
+		} else if err := c.mergeConfiguration(e, group.data, strategy, group.source); err != nil {
+			return err
+		}
+
+		for key := range group.data {
+			comment, exists := other.Comments[key]
+			if !exists || e.provenance[key] != group.source {
+				continue
+			}
+			if e.Comments == nil {
+				e.Comments = make(map[string]string)
+			}
+			e.Comments[key] = comment
+		}
+	}
+
+	e.Sources = append(e.Sources, other.Sources...)
+	return nil
+}
+
+// sourceGroup holds the keys of an environment set by one source.
+type sourceGroup struct {
+	source string
+	data   map[string]string
+}
+
+// sourceGroups splits the data of the environment by the source that set each key, ordered
+// as Sources lists them, with other sources after them in sorted order and keys without a
+// recorded source last under MergedSource.
+func (e *Environment) sourceGroups() []sourceGroup {
+	bySource := make(map[string]map[string]string)
+	for key, value := range e.Data {
+		source, exists := e.provenance[key]
+		if !exists {
+			source = MergedSource
+		}
+		if bySource[source] == nil {
+			bySource[source] = make(map[string]string)
+		}
+		bySource[source][key] = value
+	}
+
+	groups := make([]sourceGroup, 0, len(bySource))
+	for _, info := range e.Sources {
+		if data, exists := bySource[info.Name]; exists {
+			groups = append(groups, sourceGroup{source: info.Name, data: data})
+			delete(bySource, info.Name)
+		}
+	}
+
+	var rest []string
+	for source := range bySource {
+		if source != MergedSource {
+			rest = append(rest, source)
+		}
+	}
+	sort.Strings(rest)
+	if _, exists := bySource[MergedSource]; exists {
+		rest = append(rest, MergedSource)
+	}
+	for _, source := range rest {
+		groups = append(groups, sourceGroup{source: source, data: bySource[source]})
+	}
+
+	return groups
+}
+
+// keyPriority returns the provider priority recorded for the keys of a source group, or
+// DefaultProviderPriority when the environment was not loaded with MergeStrategyPriority.
+func (e *Environment) keyPriority(data map[string]string) int {
+	for key := range data {
+		if priority, exists := e.keyPriorities[key]; exists {
+			return priority
+		}
+	}
+	return DefaultProviderPriority
+}
+
+// scratchCopy returns a copy of the environment that a source can be loaded into without
+// changing the original: its keys, provenance, merge records, required keys and comments
+// are copied, so discarding the copy undoes everything the source did.
+func (e *Environment) scratchCopy() *Environment {
+	scratch := *e
+	scratch.Data = copyStringMap(e.Data)
+	scratch.Sources = append([]SourceInfo(nil), e.Sources...)
+	scratch.Warnings = append([]string(nil), e.Warnings...)
+	scratch.Comments = copyStringMap(e.Comments)
+	scratch.requiredKeys = copyStringMap(e.requiredKeys)
+	scratch.provenance = copyStringMap(e.provenance)
+
+	if e.writeOrder != nil {
+		scratch.writeOrder = make(map[string]int, len(e.writeOrder))
+		for key, order := range e.writeOrder {
+			scratch.writeOrder[key] = order
+		}
+	}
+	if e.keyPriorities != nil {
+		scratch.keyPriorities = make(map[string]int, len(e.keyPriorities))
+		for key, priority := range e.keyPriorities {
+			scratch.keyPriorities[key] = priority
+		}
+	}
+	if e.conflicts != nil {
+		scratch.conflicts = make(map[string]*MergeConflict, len(e.conflicts))
+		for key, conflict := range e.conflicts {
+			copied := *conflict
+			copied.Contributions = append([]MergeContribution(nil), conflict.Contributions...)
+			scratch.conflicts[key] = &copied
+		}
+	}
+
+	return &scratch
+}
+
+// copyStringMap returns a copy of data, or nil when data is nil.
+func copyStringMap(data map[string]string) map[string]string {
+	if data == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(data))
+	for key, value := range data {
+		copied[key] = value
+	}
+	return copied
+}
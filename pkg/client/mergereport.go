@@ -0,0 +1,84 @@
+package client
+
+import (
+	"sort"
+)
+
+// MergeContribution is a value one source offered for a key.
+type MergeContribution struct {
+	// Source is the source that offered the value.
+	Source string
+
+	// Value is the offered value.
+	Value string
+}
+
+// MergeConflict describes a key set by more than one source during a load.
+type MergeConflict struct {
+	// Key is the configuration key.
+	Key string
+
+	// Contributions lists the values offered for the key, in load order.
+	Contributions []MergeContribution
+
+	// Winner is the source whose value was kept.
+	Winner string
+}
+
+// Identical reports whether every source offered the same value.
+func (c MergeConflict) Identical() bool {
+	for _, contribution := range c.Contributions[1:] {
+		if contribution.Value != c.Contributions[0].Value {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeReport lists the keys set by more than one source and how each was resolved.
+type MergeReport struct {
+	// Strategy is the merge strategy of the load.
+	Strategy MergeStrategy
+
+	// Conflicts lists the keys set by more than one source, sorted by key.
+	Conflicts []MergeConflict
+}
+
+// recordContribution notes that a source offered a value for a key that was already set.
+// The first contribution is taken from the current value and its provenance.
+func (e *Environment) recordContribution(key, value, sourceName string) {
+	if e.conflicts == nil {
+		e.conflicts = make(map[string]*MergeConflict)
+	}
+
+	conflict, exists := e.conflicts[key]
+	if !exists {
+		conflict = &MergeConflict{
+			Key: key,
+			Contributions: []MergeContribution{
+				{Source: e.provenance[key], Value: e.Data[key]},
+			},
+		}
+		e.conflicts[key] = conflict
+	}
+
+	conflict.Contributions = append(conflict.Contributions, MergeContribution{Source: sourceName, Value: value})
+}
+
+// buildMergeReport returns the merge report of the load, naming the winner of each conflict.
+func (e *Environment) buildMergeReport(strategy MergeStrategy) MergeReport {
+	report := MergeReport{
+		Strategy:  strategy,
+		Conflicts: make([]MergeConflict, 0, len(e.conflicts)),
+	}
+
+	for key, conflict := range e.conflicts {
+		conflict.Winner = e.provenance[key]
+		report.Conflicts = append(report.Conflicts, *conflict)
+	}
+	sort.Slice(report.Conflicts, func(i, j int) bool {
+		return report.Conflicts[i].Key < report.Conflicts[j].Key
+	})
+
+	return report
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+// ExistenceChecker is implemented by providers that can cheaply confirm a source exists
+// without fetching its values, such as a metadata lookup against a remote store.
+type ExistenceChecker interface {
+	// Exists reports an error when the source cannot be resolved.
+	Exists(ctx context.Context, source string) error
+}
+
+// PreflightResult is the outcome of checking a single source.
+type PreflightResult struct {
+	// Source is the source string including an optional provider prefix.
+	Source string
+
+	// Provider is the name of the provider resolving the source.
+	Provider string
+
+	// Skipped marks optional sources that do not exist and would be skipped by Load.
+	Skipped bool
+
+	// Err is set when the source cannot be resolved.
+	Err error
+}
+
+// OK reports whether the source can be resolved.
+func (r PreflightResult) OK() bool {
+	return r.Err == nil
+}
+
+// PreflightReport is the per-source outcome of Client.Preflight.
+type PreflightReport struct {
+	Results []PreflightResult
+}
+
+// OK reports whether every source can be resolved.
+func (r *PreflightReport) OK() bool {
+	for _, result := range r.Results {
+		if !result.OK() {
+			return false
+		}
+	}
+	return true
+}
+
+// Err joins the errors of all failed sources, or returns nil when every source is OK.
+func (r *PreflightReport) Err() error {
+	var errs []error
+	for _, result := range r.Results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Source, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Preflight checks that every source of a load is resolvable without loading any values.
+// Each source's provider is looked up and its Validate method called; providers that
+// implement ExistenceChecker are also asked to confirm the source exists.
+func (c *Client) Preflight(ctx context.Context, options LoadOptions) *PreflightReport {
+	steps := planSources(options)
+	report := &PreflightReport{Results: make([]PreflightResult, 0, len(steps))}
+
+	for _, step := range steps {
+		report.Results = append(report.Results, c.preflightSource(ctx, step))
+	}
+
+	return report
+}
+
+// preflightSource checks a single source.
+func (c *Client) preflightSource(ctx context.Context, step sourceStep) PreflightResult {
+	source := c.rewriteSource(step.source)
+	providerName, actualSource := c.parseSource(source)
+	result := PreflightResult{Source: source, Provider: providerName}
+
+	// Get provider
+	provider, exists := c.providers[providerName]
+	if !exists {
+		result.Err = fmt.Errorf("provider %s not found", providerName)
+		return result
+	}
+
+	// Validate source, reporting optional sources that do not exist as skipped
+	if err := provider.Validate(actualSource); err != nil {
+		if step.optional && errors.Is(err, fs.ErrNotExist) {
+			result.Skipped = true
+			return result
+		}
+		result.Err = fmt.Errorf("source validation failed: %w", err)
+		return result
+	}
+
+	// Confirm existence when the provider supports a lightweight check
+	if checker, ok := provider.(ExistenceChecker); ok {
+		if err := checker.Exists(ctx, actualSource); err != nil {
+			result.Err = fmt.Errorf("source does not exist: %w", err)
+		}
+	}
+
+	return result
+}
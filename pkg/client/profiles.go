@@ -0,0 +1,114 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfileDefinition describes one profile of a profiles configuration file.
+type ProfileDefinition struct {
+	// Parent is the profile this one inherits from, or empty for a root profile.
+	Parent string `yaml:"parent" json:"parent"`
+
+	// Sources are the sources the profile adds on top of its parent's, in load order.
+	Sources []string `yaml:"sources" json:"sources"`
+}
+
+// ProfileConfig maps profile names to their definitions, so that a profile such as
+// production can inherit the sources of base and override them.
+type ProfileConfig struct {
+	// Profiles holds the definition of every profile by name.
+	Profiles map[string]ProfileDefinition `yaml:"profiles" json:"profiles"`
+}
+
+// LoadProfileConfig reads a profiles configuration from a YAML or JSON file of the form
+// {"profiles": {"base": {"sources": [".env"]}, "production": {"parent": "base", "sources": [...]}}}.
+func LoadProfileConfig(filePath string) (*ProfileConfig, error) {
+	// #nosec G304 - filePath is supplied by the caller
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles file %s: %w", filePath, err)
+	}
+
+	config, err := ParseProfileConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+	return config, nil
+}
+
+// ParseProfileConfig parses a profiles configuration from YAML or JSON content and checks
+// that every parent exists and that no profile inherits from itself.
+func ParseProfileConfig(data []byte) (*ProfileConfig, error) {
+	var config ProfileConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid profiles file: %w", err)
+	}
+	if len(config.Profiles) == 0 {
+		return nil, fmt.Errorf("invalid profiles file: no profiles defined")
+	}
+
+	// Check in sorted order so the reported problem does not depend on map order
+	names := make([]string, 0, len(config.Profiles))
+	for name := range config.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := config.Chain(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return &config, nil
+}
+
+// Chain returns the profile and its ancestors, root profile first.
+func (c *ProfileConfig) Chain(profile string) ([]string, error) {
+	var chain []string
+	seen := make(map[string]bool)
+	for name := profile; name != ""; name = c.Profiles[name].Parent {
+		if seen[name] {
+			return nil, fmt.Errorf("profile %s inherits from itself: %s", profile,
+				strings.Join(append(chain, name), " -> "))
+		}
+		if _, exists := c.Profiles[name]; !exists {
+			if name == profile {
+				return nil, fmt.Errorf("unknown profile %s", profile)
+			}
+			return nil, fmt.Errorf("profile %s has unknown parent %s", chain[len(chain)-1], name)
+		}
+		seen[name] = true
+		chain = append(chain, name)
+	}
+
+	return reversed(chain), nil
+}
+
+// Sources returns the sources of the profile's chain in load order: the root profile's
+// sources first and the profile's own sources last, so that later sources override.
+func (c *ProfileConfig) Sources(profile string) ([]string, error) {
+	chain, err := c.Chain(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []string
+	for _, name := range chain {
+		sources = append(sources, c.Profiles[name].Sources...)
+	}
+	return sources, nil
+}
+
+// reversed returns a reversed copy of names.
+func reversed(names []string) []string {
+	result := make([]string, len(names))
+	for i, name := range names {
+		result[len(names)-1-i] = name
+	}
+	return result
+}
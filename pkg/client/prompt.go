@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// requiredByOptions is reported as the declaration of keys from LoadOptions.RequiredKeys.
+const requiredByOptions = "load options"
+
+// requireKeys adds keys to the required keys of the environment, keeping the declaration of
+// keys already required by a directive.
+func (e *Environment) requireKeys(keys []string) {
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+
+		if e.requiredKeys == nil {
+			e.requiredKeys = make(map[string]string)
+		}
+		if _, exists := e.requiredKeys[key]; !exists {
+			e.requiredKeys[key] = requiredByOptions
+		}
+	}
+}
+
+// promptMissing asks for the value of each missing required key, in sorted order, stopping
+// once the context is done.
+func promptMissing(
+	ctx context.Context, env *Environment, prompt func(ctx context.Context, key string) (string, error),
+) error {
+	var missing []string
+	for key := range env.requiredKeys {
+		if _, exists := env.Data[key]; !exists {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+
+	for _, key := range missing {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("failed to prompt for %s: %w", key, err)
+		}
+
+		value, err := prompt(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to prompt for %s: %w", key, err)
+		}
+
+		env.Data[key] = value
+		env.setProvenance(key, PromptSource)
+		env.PromptedKeys = append(env.PromptedKeys, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Constants for secret references
+const (
+	// MaxReferenceDepth is the longest chain of references followed for a single key.
+	MaxReferenceDepth = 8
+)
+
+// referenceRegex matches a provider:source#key secret reference, such as
+// vault:secret/data/db#password.
+var referenceRegex = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9_-]*):([^#\s]+)#([^#\s]+)$`)
+
+// secretReference is a value pointing at a single key of another source.
+type secretReference struct {
+	provider string
+	source   string
+	key      string
+}
+
+// String returns the reference as written in the value.
+func (r secretReference) String() string {
+	return r.provider + ":" + r.source + "#" + r.key
+}
+
+// parseReference reports whether the value is a reference to a provider added to the
+// client. Values such as URLs with fragments are not references unless their scheme
+// names a provider.
+func (c *Client) parseReference(value string) (secretReference, bool) {
+	match := referenceRegex.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return secretReference{}, false
+	}
+	if _, exists := c.providers[match[1]]; !exists {
+		return secretReference{}, false
+	}
+
+	return secretReference{provider: match[1], source: match[2], key: match[3]}, true
+}
+
+// resolveReferences replaces every reference value with the value of the key it points
+// at. Each referenced source is loaded once per load. References to references are
+// followed up to MaxReferenceDepth; cycles, unknown keys and failed loads are errors.
+func (c *Client) resolveReferences(ctx context.Context, env *Environment) ([]string, error) {
+	keys := make([]string, 0, len(env.Data))
+	for key := range env.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	loaded := make(map[string]map[string]string)
+	var resolved []string
+	for _, key := range keys {
+		if _, isReference := c.parseReference(env.Data[key]); !isReference {
+			continue
+		}
+
+		value, err := c.followReference(ctx, env.Data[key], loaded)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		env.Data[key] = value
+		resolved = append(resolved, key)
+		c.logger.Debugf("resolved %s from a secret reference", key)
+	}
+
+	return resolved, nil
+}
+
+// followReference resolves a reference, and any reference it resolves to, to a plain value.
+func (c *Client) followReference(
+	ctx context.Context, value string, loaded map[string]map[string]string,
+) (string, error) {
+	var chain []string
+	for {
+		reference, isReference := c.parseReference(value)
+		if !isReference {
+			return value, nil
+		}
+
+		// Detect cycles and overly long chains
+		for _, seen := range chain {
+			if seen == reference.String() {
+				return "", fmt.Errorf("reference cycle: %s -> %s", strings.Join(chain, " -> "), seen)
+			}
+		}
+		if len(chain) >= MaxReferenceDepth {
+			return "", fmt.Errorf("reference chain longer than %d: %s", MaxReferenceDepth, strings.Join(chain, " -> "))
+		}
+		chain = append(chain, reference.String())
+
+		config, err := c.loadReferencedSource(ctx, reference, loaded)
+		if err != nil {
+			return "", err
+		}
+
+		next, exists := config[reference.key]
+		if !exists {
+			return "", fmt.Errorf("key %s not found in %s:%s", reference.key, reference.provider, reference.source)
+		}
+		value = next
+	}
+}
+
+// loadReferencedSource loads the source of a reference, reusing sources already loaded.
+func (c *Client) loadReferencedSource(
+	ctx context.Context, reference secretReference, loaded map[string]map[string]string,
+) (map[string]string, error) {
+	name := reference.provider + ":" + reference.source
+	if config, exists := loaded[name]; exists {
+		return config, nil
+	}
+
+	provider := c.providers[reference.provider]
+	if err := provider.Validate(reference.source); err != nil {
+		return nil, fmt.Errorf("invalid reference source %s: %w", name, err)
+	}
+
+	loadCtx, cancel := c.withProviderTimeout(ctx, reference.provider, provider)
+	defer cancel()
+
+	config, err := provider.Load(loadCtx, reference.source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reference source %s: %w", name, err)
+	}
+
+	loaded[name] = config
+	return config, nil
+}
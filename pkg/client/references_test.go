@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// countingProvider is a mapProvider that counts its loads.
+type countingProvider struct {
+	mapProvider
+	loads int
+}
+
+// Load counts the load and returns the source data.
+func (p *countingProvider) Load(ctx context.Context, source string) (map[string]string, error) {
+	p.loads++
+	return p.mapProvider.Load(ctx, source)
+}
+
+func TestLoadResolveReferences(t *testing.T) {
+	secrets := &countingProvider{mapProvider: mapProvider{sources: map[string]map[string]string{
+		"db":    {"password": "s3cret", "user": "app", "alias": "secrets:db#password"},
+		"other": {"token": "t0ken"},
+	}}}
+	c := newMapClient(map[string]map[string]string{"app": {
+		"DB_PASSWORD": "secrets:db#password",
+		"DB_USER":     "secrets:db#user",
+		"DB_ALIAS":    "secrets:db#alias",
+		"HOMEPAGE":    "https://example.com/docs#intro",
+		"PLAIN":       "value",
+	}})
+	c.AddProvider("secrets", secrets)
+
+	env, err := c.Load(context.Background(), LoadOptions{Sources: []string{"app"}, ResolveReferences: true})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	want := map[string]string{
+		"DB_PASSWORD": "s3cret",
+		"DB_USER":     "app",
+		"DB_ALIAS":    "s3cret",
+		"HOMEPAGE":    "https://example.com/docs#intro",
+		"PLAIN":       "value",
+	}
+	if !reflect.DeepEqual(env.Data, want) {
+		t.Errorf("Load() = %v, want %v", env.Data, want)
+	}
+	if wantKeys := []string{"DB_ALIAS", "DB_PASSWORD", "DB_USER"}; !reflect.DeepEqual(env.ReferencedKeys, wantKeys) {
+		t.Errorf("ReferencedKeys = %v, want %v", env.ReferencedKeys, wantKeys)
+	}
+	if secrets.loads != 1 {
+		t.Errorf("referenced source loaded %d times, want once", secrets.loads)
+	}
+}
+
+func TestLoadResolveReferencesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"missing key", "secrets:db#missing", "key missing not found in secrets:db"},
+		{"missing source", "secrets:nowhere#key", "failed to load reference source secrets:nowhere"},
+		{"cycle", "secrets:loop#a", "reference cycle"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newMapClient(map[string]map[string]string{"app": {"KEY": tt.value}})
+			c.AddProvider("secrets", &mapProvider{sources: map[string]map[string]string{
+				"db":   {"password": "s3cret"},
+				"loop": {"a": "secrets:loop#b", "b": "secrets:loop#a"},
+			}})
+
+			_, err := c.Load(context.Background(), LoadOptions{Sources: []string{"app"}, ResolveReferences: true})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Load() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// renamedEntry is a key's value with the provenance, comment and priority moved along with it.
+type renamedEntry struct {
+	winner      string
+	value       string
+	source      string
+	hasSource   bool
+	comment     string
+	hasComment  bool
+	priority    int
+	hasPriority bool
+}
+
+// renameKeys renames every key of renames present in the environment, moving its provenance
+// and comment along. All renames apply at once against the keys as they were before renaming,
+// so swapping two keys works. Renaming onto a key that is kept, or several keys onto the same
+// new key, keeps the value the merge strategy picks, as dedupeCase does for keys differing
+// only by case.
+func (c *Client) renameKeys(env *Environment, renames map[string]string, strategy MergeStrategy) error {
+	oldKeys := make([]string, 0, len(renames))
+	for oldKey, newKey := range renames {
+		if oldKey == "" || newKey == "" {
+			return fmt.Errorf("invalid key rename %q to %q: keys must not be empty", oldKey, newKey)
+		}
+		oldKeys = append(oldKeys, oldKey)
+	}
+	sort.Strings(oldKeys)
+
+	// Group the present old keys by their new key
+	sources := make(map[string][]string)
+	renamedAway := make(map[string]bool)
+	for _, oldKey := range oldKeys {
+		newKey := renames[oldKey]
+		if _, exists := env.Data[oldKey]; !exists || oldKey == newKey {
+			continue
+		}
+		sources[newKey] = append(sources[newKey], oldKey)
+		renamedAway[oldKey] = true
+	}
+
+	newKeys := make([]string, 0, len(sources))
+	for newKey := range sources {
+		newKeys = append(newKeys, newKey)
+	}
+	sort.Strings(newKeys)
+
+	// Pick every kept value before moving anything
+	entries := make(map[string]renamedEntry, len(newKeys))
+	for _, newKey := range newKeys {
+		entry, err := env.renameWinner(newKey, sources[newKey], renamedAway, strategy)
+		if err != nil {
+			return err
+		}
+		entries[newKey] = entry
+	}
+
+	// Move the kept values to their new keys
+	for oldKey := range renamedAway {
+		env.deleteKey(oldKey)
+	}
+	for _, newKey := range newKeys {
+		entry := entries[newKey]
+		env.deleteKey(newKey)
+		env.Data[newKey] = entry.value
+		if entry.hasSource {
+			env.setProvenance(newKey, entry.source)
+		}
+		if entry.hasComment {
+			env.Comments[newKey] = entry.comment
+		}
+		if entry.hasPriority {
+			env.keyPriorities[newKey] = entry.priority
+		}
+
+		c.logger.Debugf("renamed %s to %s, keeping the value of %s",
+			strings.Join(sources[newKey], ", "), newKey, entry.winner)
+	}
+
+	return nil
+}
+
+// renameWinner picks the entry newKey keeps from the old keys renamed onto it and from newKey
+// itself when it is set and not renamed away.
+func (e *Environment) renameWinner(
+	newKey string,
+	oldKeys []string,
+	renamedAway map[string]bool,
+	strategy MergeStrategy,
+) (renamedEntry, error) {
+	keys := append([]string(nil), oldKeys...)
+	if _, exists := e.Data[newKey]; exists && !renamedAway[newKey] {
+		keys = append(keys, newKey)
+	}
+
+	winner := keys[0]
+	if len(keys) > 1 {
+		var err error
+		what := fmt.Sprintf("collide when renaming %s to %s", strings.Join(oldKeys, ", "), newKey)
+		if winner, err = e.collisionWinner(keys, strategy, what); err != nil {
+			return renamedEntry{}, err
+		}
+	}
+
+	entry := renamedEntry{winner: winner, value: e.Data[winner]}
+	entry.source, entry.hasSource = e.provenance[winner]
+	entry.comment, entry.hasComment = e.Comments[winner]
+	entry.priority, entry.hasPriority = e.keyPriorities[winner]
+	return entry, nil
+}
+
+// deleteKey removes key with its provenance, comment and priority.
+func (e *Environment) deleteKey(key string) {
+	delete(e.Data, key)
+	delete(e.provenance, key)
+	delete(e.Comments, key)
+	delete(e.keyPriorities, key)
+}
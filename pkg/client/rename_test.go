@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadKeyRenames(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"base":  {"A": "a", "B": "b", "DB_URL": "postgres://base"},
+		"local": {"DATABASE_URL": "postgres://local"},
+	})
+
+	tests := []struct {
+		name     string
+		renames  map[string]string
+		strategy MergeStrategy
+		want     map[string]string
+	}{
+		{"plain rename", map[string]string{"A": "C"}, MergeStrategyOverride,
+			map[string]string{"C": "a", "B": "b", "DB_URL": "postgres://base", "DATABASE_URL": "postgres://local"}},
+		{"swap", map[string]string{"A": "B", "B": "A"}, MergeStrategyOverride,
+			map[string]string{"A": "b", "B": "a", "DB_URL": "postgres://base", "DATABASE_URL": "postgres://local"}},
+		{"swap in reverse sort order", map[string]string{"B": "A", "A": "B", "DB_URL": "B_URL"}, MergeStrategyOverride,
+			map[string]string{"A": "b", "B": "a", "B_URL": "postgres://base", "DATABASE_URL": "postgres://local"}},
+		{"rotation", map[string]string{"A": "B", "B": "DB_URL", "DB_URL": "A"}, MergeStrategyOverride,
+			map[string]string{"A": "postgres://base", "B": "a", "DB_URL": "b", "DATABASE_URL": "postgres://local"}},
+		{"missing old key", map[string]string{"MISSING": "A"}, MergeStrategyOverride,
+			map[string]string{"A": "a", "B": "b", "DB_URL": "postgres://base", "DATABASE_URL": "postgres://local"}},
+		{"collision override", map[string]string{"DB_URL": "DATABASE_URL"}, MergeStrategyOverride,
+			map[string]string{"A": "a", "B": "b", "DATABASE_URL": "postgres://local"}},
+		{"collision preserve", map[string]string{"DB_URL": "DATABASE_URL"}, MergeStrategyPreserve,
+			map[string]string{"A": "a", "B": "b", "DATABASE_URL": "postgres://base"}},
+		{"renames onto the same key", map[string]string{"A": "X", "DATABASE_URL": "X"}, MergeStrategyPreserve,
+			map[string]string{"X": "a", "B": "b", "DB_URL": "postgres://base"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, err := c.Load(context.Background(), LoadOptions{
+				Sources:       []string{"base", "local"},
+				MergeStrategy: tt.strategy,
+				KeyRenames:    tt.renames,
+			})
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(env.Data, tt.want) {
+				t.Errorf("Load() = %v, want %v", env.Data, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadKeyRenamesMovesProvenance(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"base":  {"A": "a"},
+		"local": {"B": "b"},
+	})
+
+	env, err := c.Load(context.Background(), LoadOptions{
+		Sources:    []string{"base", "local"},
+		KeyRenames: map[string]string{"A": "B", "B": "A"},
+	})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if source, _ := env.Provenance("A"); source != "local" {
+		t.Errorf("Provenance(A) = %q, want local", source)
+	}
+	if source, _ := env.Provenance("B"); source != "base" {
+		t.Errorf("Provenance(B) = %q, want base", source)
+	}
+}
+
+func TestLoadKeyRenamesErrors(t *testing.T) {
+	c := newMapClient(map[string]map[string]string{
+		"base": {"DB_URL": "postgres://base", "DATABASE_URL": "postgres://local"},
+	})
+
+	tests := []struct {
+		name     string
+		renames  map[string]string
+		strategy MergeStrategy
+		wantErr  string
+	}{
+		{"collision error", map[string]string{"DB_URL": "DATABASE_URL"}, MergeStrategyError,
+			"collide when renaming DB_URL to DATABASE_URL"},
+		{"collision error on conflict", map[string]string{"DB_URL": "DATABASE_URL"}, MergeStrategyErrorOnConflict,
+			"have different values"},
+		{"empty old key", map[string]string{"": "A"}, MergeStrategyOverride, "keys must not be empty"},
+		{"empty new key", map[string]string{"A": ""}, MergeStrategyOverride, "keys must not be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Load(context.Background(), LoadOptions{
+				Sources:       []string{"base"},
+				MergeStrategy: tt.strategy,
+				KeyRenames:    tt.renames,
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
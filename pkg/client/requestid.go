@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Constants for request IDs
+const (
+	// RequestIDBytes is the number of random bytes in a generated request ID.
+	RequestIDBytes = 8
+)
+
+// requestIDKey is the context key for request IDs.
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, or an empty string if none is set.
+// Providers can include it in log lines and error messages to correlate a load.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// ensureRequestID returns ctx with a generated request ID attached when none is present.
+func ensureRequestID(ctx context.Context) context.Context {
+	if RequestIDFromContext(ctx) != "" {
+		return ctx
+	}
+
+	return WithRequestID(ctx, newRequestID())
+}
+
+// newRequestID generates a random hex request ID.
+func newRequestID() string {
+	buffer := make([]byte, RequestIDBytes)
+	if _, err := rand.Read(buffer); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buffer)
+}
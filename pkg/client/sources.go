@@ -0,0 +1,123 @@
+package client
+
+import "sort"
+
+// Constants for source planning
+const (
+	// LocalLayerSuffix is the suffix of the machine-local override layer in profile layering.
+	LocalLayerSuffix = ".local"
+)
+
+// SourceRewriter rewrites a source string before it is resolved to a provider.
+type SourceRewriter func(source string) string
+
+// SetSourceRewriter sets a function applied to every source before it is loaded, for example
+// to expand "vault:secret/app/{{env}}" centrally. The rewriter receives the full source string,
+// including its provider prefix, and runs before the prefix is parsed, so it may also change
+// the provider. Profile layers are expanded first and each layer is rewritten on its own.
+// A nil rewriter leaves sources unchanged.
+func (c *Client) SetSourceRewriter(rewriter SourceRewriter) {
+	c.sourceRewriter = rewriter
+}
+
+// rewriteSource applies the configured source rewriter, if any.
+func (c *Client) rewriteSource(source string) string {
+	if c.sourceRewriter == nil {
+		return source
+	}
+	return c.sourceRewriter(source)
+}
+
+// SourceSpec is a source with its own merge strategy.
+type SourceSpec struct {
+	// Source is the source string including an optional provider prefix.
+	Source string
+
+	// Strategy is the merge strategy used when merging this source.
+	// When nil, LoadOptions.MergeStrategy is used.
+	Strategy *MergeStrategy
+
+	// OnlyKeys lists the keys, or glob patterns, taken from this source; all other keys
+	// are dropped before merging. An empty list takes every key.
+	OnlyKeys []string
+}
+
+// sourceStep is a single source to load with its merge behavior.
+type sourceStep struct {
+	// source is the source string including an optional provider prefix.
+	source string
+
+	// strategy is the merge strategy applied when merging this source.
+	strategy MergeStrategy
+
+	// optional marks sources that are skipped when they do not exist.
+	optional bool
+
+	// onlyKeys restricts the keys merged from this source. Empty means all keys.
+	onlyKeys []string
+}
+
+// planSources expands the load options into the ordered list of sources to load.
+// Plain Sources are loaded first, followed by SourceSpecs.
+func planSources(options LoadOptions) []sourceStep {
+	steps := make([]sourceStep, 0, len(options.Sources)+len(options.SourceSpecs))
+
+	for _, source := range options.Sources {
+		if options.Profile == "" {
+			steps = append(steps, sourceStep{source: source, strategy: options.MergeStrategy})
+			continue
+		}
+
+		steps = append(steps, profileLayers(source, options.Profile, MergeStrategyOverride)...)
+	}
+
+	for _, spec := range options.SourceSpecs {
+		strategy := options.MergeStrategy
+		if spec.Strategy != nil {
+			strategy = *spec.Strategy
+		}
+
+		if options.Profile == "" {
+			steps = append(steps, sourceStep{source: spec.Source, strategy: strategy, onlyKeys: spec.OnlyKeys})
+			continue
+		}
+
+		// Without an explicit strategy the base layer overrides, as for plain sources
+		baseStrategy := MergeStrategyOverride
+		if spec.Strategy != nil {
+			baseStrategy = *spec.Strategy
+		}
+		for _, layer := range profileLayers(spec.Source, options.Profile, baseStrategy) {
+			layer.onlyKeys = spec.OnlyKeys
+			steps = append(steps, layer)
+		}
+	}
+
+	return steps
+}
+
+// profileLayers expands a base source into its conventional profile layers:
+// base, base.local, base.<profile>. The base layer merges with baseStrategy;
+// the .local and profile layers always override earlier values.
+func profileLayers(base, profile string, baseStrategy MergeStrategy) []sourceStep {
+	return []sourceStep{
+		{source: base, strategy: baseStrategy},
+		{source: base + LocalLayerSuffix, strategy: MergeStrategyOverride, optional: true},
+		{source: base + "." + profile, strategy: MergeStrategyOverride, optional: true},
+	}
+}
+
+// applyDefaults sets every default whose key is absent from data and returns the sorted
+// keys that were set.
+func applyDefaults(data, defaults map[string]string) []string {
+	var applied []string
+	for key, value := range defaults {
+		if _, exists := data[key]; exists {
+			continue
+		}
+		data[key] = value
+		applied = append(applied, key)
+	}
+	sort.Strings(applied)
+	return applied
+}
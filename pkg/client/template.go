@@ -0,0 +1,93 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Constants for value templating
+const (
+	// templateActionStart marks values that contain template actions.
+	templateActionStart = "{{"
+)
+
+// TemplateFuncs returns the functions available to value templates.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"default": func(fallback, value string) string {
+			if value == "" {
+				return fallback
+			}
+			return value
+		},
+		"env": os.Getenv,
+	}
+}
+
+// renderTemplates renders every value containing template actions as a Go text/template,
+// with the configuration as it was before rendering as data. Missing keys render as an
+// empty string, or fail when strict is true. It returns the keys whose values changed.
+func renderTemplates(data map[string]string, strict bool) ([]string, error) {
+	keys := make([]string, 0, len(data))
+	for key, value := range data {
+		if strings.Contains(value, templateActionStart) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	missingKey := "missingkey=zero"
+	if strict {
+		missingKey = "missingkey=error"
+	}
+
+	// Render against a snapshot so results do not depend on key order
+	snapshot := make(map[string]string, len(data))
+	for key, value := range data {
+		snapshot[key] = value
+	}
+
+	var changed []string
+	for _, key := range keys {
+		tmpl, err := template.New(key).Funcs(TemplateFuncs()).Option(missingKey).Parse(data[key])
+		if err != nil {
+			return nil, fmt.Errorf("invalid template in key %s: %w", key, err)
+		}
+
+		var rendered strings.Builder
+		if err := tmpl.Execute(&rendered, snapshot); err != nil {
+			return nil, fmt.Errorf("failed to render template in key %s: %w", key, err)
+		}
+
+		if rendered.String() != data[key] {
+			data[key] = rendered.String()
+			changed = append(changed, key)
+		}
+	}
+
+	return changed, nil
+}
+
+// mergeSortedKeys returns the sorted union of two sorted key lists.
+func mergeSortedKeys(a, b []string) []string {
+	if len(b) == 0 {
+		return a
+	}
+
+	seen := make(map[string]bool, len(a)+len(b))
+	merged := make([]string, 0, len(a)+len(b))
+	for _, key := range append(append([]string(nil), a...), b...) {
+		if !seen[key] {
+			seen[key] = true
+			merged = append(merged, key)
+		}
+	}
+	sort.Strings(merged)
+
+	return merged
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// TimeoutProvider is an optional interface for providers that carry their own load timeout,
+// typically read from the "timeout" key of their registry configuration.
+type TimeoutProvider interface {
+	// Timeout returns the maximum duration of a single Load call, or zero for no limit.
+	Timeout() time.Duration
+}
+
+// SetProviderTimeout sets the maximum duration of a single Load call for a provider,
+// overriding the provider's own timeout. A non-positive timeout removes the override.
+// The per-provider timeout never extends the deadline of the context passed to Load.
+func (c *Client) SetProviderTimeout(name string, timeout time.Duration) {
+	if timeout <= 0 {
+		delete(c.timeouts, name)
+		return
+	}
+	c.timeouts[name] = timeout
+}
+
+// providerTimeout returns the load timeout for a provider, or zero when none is set.
+func (c *Client) providerTimeout(name string, provider Provider) time.Duration {
+	if timeout, exists := c.timeouts[name]; exists {
+		return timeout
+	}
+
+	if timed, ok := provider.(TimeoutProvider); ok && timed.Timeout() > 0 {
+		return timed.Timeout()
+	}
+
+	return 0
+}
+
+// withProviderTimeout derives a child context bounded by the provider's timeout.
+// Without a timeout the parent context, and its deadline, is used as is.
+func (c *Client) withProviderTimeout(
+	ctx context.Context, name string, provider Provider,
+) (context.Context, context.CancelFunc) {
+	timeout := c.providerTimeout(name, provider)
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
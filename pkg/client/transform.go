@@ -0,0 +1,180 @@
+package client
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Constants for value transformers
+const (
+	// Base64ValuePrefix marks values decoded by Base64DecodeTransformer.
+	Base64ValuePrefix = "base64:"
+)
+
+// ValueTransformer transforms merged values before validation and export.
+type ValueTransformer interface {
+	// Transform returns the new value for a key.
+	Transform(key, value string) (string, error)
+}
+
+// KeyTransformer rewrites merged keys before values are transformed.
+type KeyTransformer interface {
+	// TransformKey returns the new name for a key.
+	TransformKey(key string) (string, error)
+}
+
+// TrimSpaceTransformer removes leading and trailing whitespace from every value.
+type TrimSpaceTransformer struct{}
+
+// Transform trims whitespace from the value.
+func (TrimSpaceTransformer) Transform(_, value string) (string, error) {
+	return strings.TrimSpace(value), nil
+}
+
+// Base64DecodeTransformer decodes values with a "base64:" prefix. Other values are unchanged.
+type Base64DecodeTransformer struct{}
+
+// Transform decodes a "base64:" prefixed value.
+func (Base64DecodeTransformer) Transform(_, value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, Base64ValuePrefix)
+	if !ok {
+		return value, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", fmt.Errorf("invalid base64 value: %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+// AddValueTransformer appends a transformer to the pipeline run over merged values
+// in Load, before validation. Transformers run in the order they were added.
+func (c *Client) AddValueTransformer(transformer ValueTransformer) {
+	if transformer != nil {
+		c.transformers = append(c.transformers, transformer)
+	}
+}
+
+// AddKeyTransformer appends a transformer to the pipeline that renames merged keys in Load,
+// before value transformers run. Transformers run in the order they were added.
+func (c *Client) AddKeyTransformer(transformer KeyTransformer) {
+	if transformer != nil {
+		c.keyTransformers = append(c.keyTransformers, transformer)
+	}
+}
+
+// applyKeyTransformers renames every key through the key transformers in sorted key order,
+// moving provenance along. Two keys renamed to the same name are an error.
+func (c *Client) applyKeyTransformers(env *Environment) error {
+	if len(c.keyTransformers) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(env.Data))
+	for key := range env.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	renamed := make(map[string]string, len(env.Data))
+	origins := make(map[string]string, len(env.Data))
+	for _, key := range keys {
+		newKey := key
+		for _, transformer := range c.keyTransformers {
+			var err error
+			if newKey, err = transformer.TransformKey(newKey); err != nil {
+				return fmt.Errorf("failed to transform key %s: %w", key, err)
+			}
+		}
+		if newKey == "" {
+			return fmt.Errorf("failed to transform key %s: transformed key is empty", key)
+		}
+
+		if origin, exists := origins[newKey]; exists {
+			return fmt.Errorf("keys %s and %s both transform to %s", origin, key, newKey)
+		}
+		origins[newKey] = key
+		renamed[newKey] = env.Data[key]
+	}
+
+	// Move provenance to the new keys
+	if env.provenance != nil {
+		provenance := make(map[string]string, len(env.provenance))
+		for newKey, key := range origins {
+			if source, exists := env.provenance[key]; exists {
+				provenance[newKey] = source
+			}
+		}
+		env.provenance = provenance
+	}
+
+	// Move comments to the new keys
+	if env.Comments != nil {
+		comments := make(map[string]string, len(env.Comments))
+		for newKey, key := range origins {
+			if comment, exists := env.Comments[key]; exists {
+				comments[newKey] = comment
+			}
+		}
+		env.Comments = comments
+	}
+
+	// Rename the keys listed as resolved, referenced and defaulted
+	newKeys := make(map[string]string, len(origins))
+	for newKey, key := range origins {
+		newKeys[key] = newKey
+	}
+	env.ResolvedKeys = renameKeyList(env.ResolvedKeys, newKeys)
+	env.ReferencedKeys = renameKeyList(env.ReferencedKeys, newKeys)
+	env.DefaultedKeys = renameKeyList(env.DefaultedKeys, newKeys)
+
+	env.Data = renamed
+	return nil
+}
+
+// renameKeyList returns the sorted list of keys with each key replaced by its new name.
+func renameKeyList(keys []string, newKeys map[string]string) []string {
+	if len(keys) == 0 {
+		return keys
+	}
+
+	renamed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if newKey, exists := newKeys[key]; exists {
+			key = newKey
+		}
+		renamed = append(renamed, key)
+	}
+	sort.Strings(renamed)
+	return renamed
+}
+
+// applyTransformers runs every transformer over each value in sorted key order.
+func (c *Client) applyTransformers(data map[string]string) error {
+	if len(c.transformers) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := data[key]
+		for _, transformer := range c.transformers {
+			var err error
+			if value, err = transformer.Transform(key, value); err != nil {
+				return fmt.Errorf("failed to transform value for key %s: %w", key, err)
+			}
+		}
+		data[key] = value
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package client
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Transform rule operations
+const (
+	// TransformTrim removes leading and trailing whitespace from values.
+	TransformTrim = "trim"
+
+	// TransformUpper converts values to upper case.
+	TransformUpper = "upper"
+
+	// TransformLower converts values to lower case.
+	TransformLower = "lower"
+
+	// TransformBase64Decode decodes base64-encoded values.
+	TransformBase64Decode = "base64-decode"
+
+	// TransformKeyUpper converts key names to upper case.
+	TransformKeyUpper = "key-upper"
+
+	// TransformKeyLower converts key names to lower case.
+	TransformKeyLower = "key-lower"
+)
+
+// TransformRule applies an operation to the values or names of keys matching a glob.
+type TransformRule struct {
+	// Keys is the glob pattern of keys the rule applies to, using path.Match semantics.
+	Keys string `yaml:"keys" json:"keys"`
+
+	// Operation is one of the Transform* operations.
+	Operation string `yaml:"operation" json:"operation"`
+
+	// Line is the line of the rule in its configuration file, or zero.
+	Line int `yaml:"-" json:"-"`
+}
+
+// transformRulesFile is the layout of a transforms configuration file.
+type transformRulesFile struct {
+	Rules []yaml.Node `yaml:"rules"`
+}
+
+// LoadTransformRules reads transform rules from a YAML or JSON file of the form
+// {"rules": [{"keys": "DB_*", "operation": "key-upper"}]}.
+func LoadTransformRules(filePath string) ([]TransformRule, error) {
+	// #nosec G304 - filePath is supplied by the caller
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transforms file %s: %w", filePath, err)
+	}
+
+	rules, err := ParseTransformRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+	return rules, nil
+}
+
+// ParseTransformRules parses and checks transform rules from YAML or JSON content.
+// Errors name the line of the offending rule.
+func ParseTransformRules(data []byte) ([]TransformRule, error) {
+	var file transformRulesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid transforms file: %w", err)
+	}
+
+	rules := make([]TransformRule, 0, len(file.Rules))
+	for i := range file.Rules {
+		node := &file.Rules[i]
+
+		var rule TransformRule
+		if err := node.Decode(&rule); err != nil {
+			return nil, fmt.Errorf("line %d: invalid rule: %w", node.Line, err)
+		}
+		rule.Line = node.Line
+
+		if err := rule.check(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// check reports an empty or invalid glob or an unknown operation.
+func (r TransformRule) check() error {
+	if strings.TrimSpace(r.Keys) == "" {
+		return fmt.Errorf("rule has no keys pattern")
+	}
+	if _, err := path.Match(r.Keys, ""); err != nil {
+		return fmt.Errorf("invalid keys pattern %s: %w", r.Keys, err)
+	}
+
+	switch r.Operation {
+	case TransformTrim, TransformUpper, TransformLower, TransformBase64Decode,
+		TransformKeyUpper, TransformKeyLower:
+		return nil
+	case "":
+		return fmt.Errorf("rule for %s has no operation", r.Keys)
+	default:
+		return fmt.Errorf("unknown operation %q for %s (valid: %s)", r.Operation, r.Keys, strings.Join([]string{
+			TransformTrim, TransformUpper, TransformLower, TransformBase64Decode, TransformKeyUpper, TransformKeyLower,
+		}, ", "))
+	}
+}
+
+// matches reports whether the rule applies to the key.
+func (r TransformRule) matches(key string) bool {
+	matched, _ := path.Match(r.Keys, key)
+	return matched
+}
+
+// isKeyOperation reports whether the rule renames keys rather than transforming values.
+func (r TransformRule) isKeyOperation() bool {
+	return r.Operation == TransformKeyUpper || r.Operation == TransformKeyLower
+}
+
+// RuleTransformer applies transform rules in order. It is both a KeyTransformer, running
+// the key-* rules, and a ValueTransformer, running the others against the renamed keys.
+type RuleTransformer struct {
+	rules []TransformRule
+}
+
+// NewRuleTransformer creates a transformer for the rules, checking each of them.
+func NewRuleTransformer(rules []TransformRule) (*RuleTransformer, error) {
+	for _, rule := range rules {
+		if err := rule.check(); err != nil {
+			if rule.Line > 0 {
+				return nil, fmt.Errorf("line %d: %w", rule.Line, err)
+			}
+			return nil, err
+		}
+	}
+
+	return &RuleTransformer{rules: append([]TransformRule(nil), rules...)}, nil
+}
+
+// TransformKey applies the matching key rules to a key name.
+func (t *RuleTransformer) TransformKey(key string) (string, error) {
+	for _, rule := range t.rules {
+		if !rule.isKeyOperation() || !rule.matches(key) {
+			continue
+		}
+
+		if rule.Operation == TransformKeyUpper {
+			key = strings.ToUpper(key)
+		} else {
+			key = strings.ToLower(key)
+		}
+	}
+
+	return key, nil
+}
+
+// Transform applies the matching value rules to a value.
+func (t *RuleTransformer) Transform(key, value string) (string, error) {
+	for _, rule := range t.rules {
+		if rule.isKeyOperation() || !rule.matches(key) {
+			continue
+		}
+
+		switch rule.Operation {
+		case TransformTrim:
+			value = strings.TrimSpace(value)
+		case TransformUpper:
+			value = strings.ToUpper(value)
+		case TransformLower:
+			value = strings.ToLower(value)
+		case TransformBase64Decode:
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+			if err != nil {
+				return "", fmt.Errorf("invalid base64 value: %w", err)
+			}
+			value = string(decoded)
+		}
+	}
+
+	return value, nil
+}
+
+// AddTransformRules adds a RuleTransformer for the rules to both the key and value pipelines.
+func (c *Client) AddTransformRules(rules []TransformRule) error {
+	transformer, err := NewRuleTransformer(rules)
+	if err != nil {
+		return err
+	}
+
+	c.AddKeyTransformer(transformer)
+	c.AddValueTransformer(transformer)
+	return nil
+}
@@ -0,0 +1,29 @@
+// Package dotenv provides the .env value quoting shared by the exporter and the local
+// provider's writer, so neither has to depend on the other.
+package dotenv
+
+import (
+	"strings"
+)
+
+// valueEscaper escapes characters inside double-quoted .env values.
+var valueEscaper = strings.NewReplacer(
+	"\\", "\\\\",
+	"\"", "\\\"",
+	"\n", "\\n",
+	"\r", "\\r",
+	"$", "\\$",
+)
+
+// EscapeValue escapes a value for .env format.
+// Values are double-quoted when needed, using the escapes understood by dotenv parsers:
+// newlines become \n so multiline values (e.g. PEM keys) survive a round trip,
+// and $ is escaped to prevent variable expansion on reload.
+func EscapeValue(value string) string {
+	// If value contains spaces or special characters, quote it
+	if strings.ContainsAny(value, " \t\n\r\"'\\$#") {
+		return `"` + valueEscaper.Replace(value) + `"`
+	}
+
+	return value
+}
@@ -0,0 +1,170 @@
+// Package encryption provides passphrase-based encryption for exported configuration files.
+//
+// Files are encrypted with AES-256-GCM. The key is derived from the passphrase with
+// scrypt (N=32768, r=8, p=1) using a random 16-byte salt per file. The encrypted file
+// is text: a header line followed by the base64 encoding of salt || nonce || ciphertext.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Constants for encryption
+const (
+	// Header is the first line of every encrypted file.
+	Header = "go-envsync:encrypted:v1"
+
+	// KeyEnvVar is the environment variable holding the passphrase when no key is given explicitly.
+	KeyEnvVar = "ENVSYNC_ENCRYPTION_KEY"
+
+	// SaltSize is the size of the random scrypt salt in bytes.
+	SaltSize = 16
+
+	// KeySize is the size of the derived AES-256 key in bytes.
+	KeySize = 32
+
+	// ScryptN is the scrypt CPU/memory cost parameter.
+	ScryptN = 32768
+
+	// ScryptR is the scrypt block size parameter.
+	ScryptR = 8
+
+	// ScryptP is the scrypt parallelization parameter.
+	ScryptP = 1
+)
+
+// ResolvePassphrase returns the explicit passphrase, or the value of KeyEnvVar when empty.
+func ResolvePassphrase(passphrase string) (string, error) {
+	if passphrase != "" {
+		return passphrase, nil
+	}
+
+	if value := os.Getenv(KeyEnvVar); value != "" {
+		return value, nil
+	}
+
+	return "", fmt.Errorf("no encryption passphrase provided (set %s or pass a key)", KeyEnvVar)
+}
+
+// ReadPassphraseFile reads a passphrase from the file, ignoring trailing line endings, so the
+// passphrase does not have to appear on the command line.
+func ReadPassphraseFile(filePath string) (string, error) {
+	// #nosec G304 - reading the passphrase file named by the user is the intended behavior
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase file: %w", err)
+	}
+
+	passphrase := strings.TrimRight(string(data), "\r\n")
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase file %s is empty", filePath)
+	}
+	return passphrase, nil
+}
+
+// IsEncrypted reports whether data starts with the encrypted file header.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(Header+"\n"))
+}
+
+// Encrypt encrypts plaintext with a key derived from the passphrase.
+func Encrypt(plaintext []byte, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase cannot be empty")
+	}
+
+	salt := make([]byte, SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	payload := make([]byte, 0, len(salt)+len(nonce)+len(plaintext)+aead.Overhead())
+	payload = append(payload, salt...)
+	payload = append(payload, nonce...)
+	payload = aead.Seal(payload, nonce, plaintext, []byte(Header))
+
+	var out bytes.Buffer
+	out.WriteString(Header + "\n")
+	out.WriteString(base64.StdEncoding.EncodeToString(payload))
+	out.WriteString("\n")
+	return out.Bytes(), nil
+}
+
+// Decrypt decrypts data produced by Encrypt.
+func Decrypt(data []byte, passphrase string) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, fmt.Errorf("data is not encrypted by go-envsync")
+	}
+
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase cannot be empty")
+	}
+
+	encoded := bytes.TrimSpace(data[len(Header)+1:])
+	payload := make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+	n, err := base64.StdEncoding.Decode(payload, encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted payload: %w", err)
+	}
+	payload = payload[:n]
+
+	if len(payload) < SaltSize {
+		return nil, fmt.Errorf("encrypted payload too short")
+	}
+
+	aead, err := newAEAD(passphrase, payload[:SaltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	rest := payload[SaltSize:]
+	if len(rest) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted payload too short")
+	}
+
+	plaintext, err := aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], []byte(Header))
+	if err != nil {
+		return nil, fmt.Errorf("decryption failed (wrong passphrase or corrupted file)")
+	}
+
+	return plaintext, nil
+}
+
+// newAEAD derives a key from the passphrase and salt and returns an AES-GCM cipher.
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, ScryptN, ScryptR, ScryptP, KeySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return aead, nil
+}
@@ -0,0 +1,81 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Constants for schema annotations
+const (
+	// RequiredMarker prefixes the comment of keys listed as required by the schema.
+	RequiredMarker = "[required]"
+)
+
+// KeyAnnotation describes a key in annotated .env output.
+type KeyAnnotation struct {
+	// Description is written as comment lines above the key.
+	Description string
+
+	// Required marks keys listed in the schema's required array.
+	Required bool
+}
+
+// LoadSchemaAnnotations reads per-key descriptions and required markers from a JSON schema.
+// Keys come from the schema's top-level properties; properties without a description
+// are only annotated when required.
+func LoadSchemaAnnotations(schemaPath string) (map[string]KeyAnnotation, error) {
+	// #nosec G304 - schemaPath is provided by the caller
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+
+	var schema struct {
+		Properties map[string]struct {
+			Description string `json:"description"`
+		} `json:"properties"`
+		Required []string `json:"required"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema %s: %w", schemaPath, err)
+	}
+
+	annotations := make(map[string]KeyAnnotation, len(schema.Properties))
+	for key, property := range schema.Properties {
+		if property.Description != "" {
+			annotations[key] = KeyAnnotation{Description: property.Description}
+		}
+	}
+	for _, key := range schema.Required {
+		annotation := annotations[key]
+		annotation.Required = true
+		annotations[key] = annotation
+	}
+
+	return annotations, nil
+}
+
+// SetAnnotations configures per-key comments written above keys in .env output.
+// Annotations are looked up by the key before KeyPrefix is applied. Nil disables annotations.
+func (e *MultiFormatExporter) SetAnnotations(annotations map[string]KeyAnnotation) {
+	e.annotations = annotations
+}
+
+// writeAnnotation writes the comment lines describing a key, if it has an annotation.
+func (e *MultiFormatExporter) writeAnnotation(content *strings.Builder, key string) {
+	annotation, exists := e.annotations[strings.TrimPrefix(key, e.options.KeyPrefix)]
+	if !exists {
+		return
+	}
+
+	lines := strings.Split(strings.TrimSpace(annotation.Description), "\n")
+	if annotation.Required {
+		lines[0] = strings.TrimSpace(RequiredMarker + " " + lines[0])
+	}
+
+	for _, line := range lines {
+		content.WriteString("# " + strings.TrimSpace(line) + "\n")
+	}
+}
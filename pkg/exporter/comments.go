@@ -0,0 +1,128 @@
+package exporter
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Constants for comment export
+const (
+	// CommentFieldPrefix prefixes the JSON fields holding key comments, so the comment of
+	// DATABASE_URL is written as "_comment_DATABASE_URL" next to it.
+	CommentFieldPrefix = "_comment_"
+)
+
+// SetComments configures comments written next to keys: as comment lines above the key in
+// .env output, as head comments in YAML output and as CommentFieldPrefix fields in JSON output.
+// Comments are looked up by the key before KeyPrefix is applied. Nil disables comments.
+func (e *MultiFormatExporter) SetComments(comments map[string]string) {
+	e.comments = comments
+}
+
+// comment returns the comment of a key, or an empty string.
+func (e *MultiFormatExporter) comment(key string) string {
+	return strings.TrimSpace(e.comments[strings.TrimPrefix(key, e.options.KeyPrefix)])
+}
+
+// writeComment writes the comment lines of a key in .env format, if it has a comment.
+func (e *MultiFormatExporter) writeComment(content *strings.Builder, key string) {
+	comment := e.comment(key)
+	if comment == "" {
+		return
+	}
+
+	for _, line := range strings.Split(comment, "\n") {
+		content.WriteString(strings.TrimRight("# "+line, " ") + "\n")
+	}
+}
+
+// commentPath returns the segments of the path a key is written under in JSON/YAML output.
+func (e *MultiFormatExporter) commentPath(key string) []string {
+	if delimiter := e.nestDelimiter(); delimiter != "" {
+		return strings.Split(key, delimiter)
+	}
+	return []string{key}
+}
+
+// withJSONComments adds a CommentFieldPrefix field next to every commented key of the
+// structured configuration.
+func (e *MultiFormatExporter) withJSONComments(config map[string]string, value interface{}) interface{} {
+	if len(e.comments) == 0 {
+		return value
+	}
+
+	root, isObject := value.(map[string]interface{})
+	if !isObject {
+		root = make(map[string]interface{}, len(config))
+		for key, configValue := range config {
+			root[key] = configValue
+		}
+	}
+
+	for key := range config {
+		comment := e.comment(key)
+		if comment == "" {
+			continue
+		}
+
+		segments := e.commentPath(key)
+		parent := root
+		for _, segment := range segments[:len(segments)-1] {
+			if parent, _ = parent[segment].(map[string]interface{}); parent == nil {
+				break
+			}
+		}
+		if parent != nil {
+			parent[CommentFieldPrefix+segments[len(segments)-1]] = comment
+		}
+	}
+
+	return root
+}
+
+// withYAMLComments encodes the structured configuration as a YAML node with the comment
+// of every commented key attached as a head comment.
+func (e *MultiFormatExporter) withYAMLComments(config map[string]string, value interface{}) (interface{}, error) {
+	if len(e.comments) == 0 {
+		return value, nil
+	}
+
+	var root yaml.Node
+	if err := root.Encode(value); err != nil {
+		return nil, err
+	}
+
+	for key := range config {
+		comment := e.comment(key)
+		if comment == "" {
+			continue
+		}
+
+		if keyNode := findYAMLKey(&root, e.commentPath(key)); keyNode != nil {
+			keyNode.HeadComment = comment
+		}
+	}
+
+	return &root, nil
+}
+
+// findYAMLKey returns the key node at the path of mapping keys, or nil.
+func findYAMLKey(node *yaml.Node, segments []string) *yaml.Node {
+	var keyNode *yaml.Node
+	for _, segment := range segments {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		mapping := node
+		keyNode, node = nil, nil
+		for i := 0; i+1 < len(mapping.Content); i += 2 {
+			if mapping.Content[i].Value == segment {
+				keyNode, node = mapping.Content[i], mapping.Content[i+1]
+				break
+			}
+		}
+	}
+	return keyNode
+}
@@ -0,0 +1,56 @@
+package exporter
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Constants for docker-compose export
+const (
+	// FormatComposeEnv represents a docker-compose environment block.
+	FormatComposeEnv = "compose"
+
+	// ComposeEnvironmentKey is the compose service key holding environment variables.
+	ComposeEnvironmentKey = "environment"
+
+	// manifestIndent is the YAML indentation of compose files and Kubernetes manifests.
+	manifestIndent = 2
+)
+
+// composeValueEscaper escapes compose variable interpolation in values.
+var composeValueEscaper = strings.NewReplacer("$", "$$")
+
+// renderComposeEnv renders configuration as a YAML sequence of KEY=value strings under an
+// environment key, or as a bare sequence with Options.ComposeStandalone, ready to be merged
+// into a compose service. Keys are sorted and $ is doubled so compose does not interpolate it.
+func (e *MultiFormatExporter) renderComposeEnv(config map[string]string) (string, error) {
+	entries := make([]string, 0, len(config))
+	for _, key := range sortedKeys(config) {
+		entries = append(entries, key+"="+composeValueEscaper.Replace(config[key]))
+	}
+
+	var document interface{} = map[string][]string{ComposeEnvironmentKey: entries}
+	if e.options.ComposeStandalone {
+		document = entries
+	}
+
+	var content strings.Builder
+	if !e.options.NoMetadata {
+		content.WriteString("# Compose environment exported by go-envsync\n")
+		content.WriteString("# Generated automatically - do not edit manually\n")
+	}
+
+	// Indent like compose files conventionally are
+	encoder := yaml.NewEncoder(&content)
+	encoder.SetIndent(manifestIndent)
+	if err := encoder.Encode(document); err != nil {
+		return "", fmt.Errorf("failed to marshal compose environment: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal compose environment: %w", err)
+	}
+
+	return content.String(), nil
+}
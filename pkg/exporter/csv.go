@@ -0,0 +1,44 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+)
+
+// Constants for CSV export
+const (
+	// FormatCSV represents a two-column key,value CSV file.
+	FormatCSV = "csv"
+
+	// CSVKeyColumn is the header of the key column in CSV output.
+	CSVKeyColumn = "key"
+
+	// CSVValueColumn is the header of the value column in CSV output.
+	CSVValueColumn = "value"
+)
+
+// renderCSV renders configuration as a key,value CSV with a header row.
+// CSV has no comment syntax, so no metadata is written.
+func (e *MultiFormatExporter) renderCSV(config map[string]string) (string, error) {
+	var content strings.Builder
+	writer := csv.NewWriter(&content)
+
+	if err := writer.Write([]string{CSVKeyColumn, CSVValueColumn}); err != nil {
+		return "", fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	// Write key-value pairs in sorted order
+	for _, key := range sortedKeys(config) {
+		if err := writer.Write([]string{key, config[key]}); err != nil {
+			return "", fmt.Errorf("failed to write CSV row for key %s: %w", key, err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return content.String(), nil
+}
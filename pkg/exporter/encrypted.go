@@ -0,0 +1,72 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Gosayram/go-envsync/pkg/encryption"
+)
+
+// EncryptedExporter wraps a MultiFormatExporter and encrypts rendered content before writing.
+// The key is derived from a passphrase with scrypt; see the encryption package for details.
+type EncryptedExporter struct {
+	exporter   *MultiFormatExporter
+	passphrase string
+}
+
+// NewEncryptedExporter creates a new exporter that encrypts output with the given passphrase.
+func NewEncryptedExporter(exporter *MultiFormatExporter, passphrase string) (*EncryptedExporter, error) {
+	if exporter == nil {
+		return nil, fmt.Errorf("exporter cannot be nil")
+	}
+
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase cannot be empty")
+	}
+
+	return &EncryptedExporter{
+		exporter:   exporter,
+		passphrase: passphrase,
+	}, nil
+}
+
+// Export renders configuration in the destination format, encrypts it, and writes the result.
+func (e *EncryptedExporter) Export(ctx context.Context, config map[string]string, destination string) error {
+	_, err := e.ExportChanged(ctx, config, destination)
+	return err
+}
+
+// ExportChanged exports configuration like Export and reports whether the destination was written.
+// Since every encryption uses a fresh salt and nonce, an existing file is decrypted and its
+// plaintext compared, and it is left untouched when the content would not change.
+func (e *EncryptedExporter) ExportChanged(
+	_ context.Context, config map[string]string, destination string,
+) (bool, error) {
+	// Parse destination format and path
+	format, filePath, err := e.exporter.parseDestination(destination)
+	if err != nil {
+		return false, err
+	}
+
+	// Render content in the requested format
+	content, err := e.exporter.render(config, format)
+	if err != nil {
+		return false, err
+	}
+
+	// Skip writing files whose decrypted content would not change
+	if existing, readErr := readExisting(filePath); readErr == nil && encryption.IsEncrypted(existing) {
+		if plaintext, decryptErr := encryption.Decrypt(existing, e.passphrase); decryptErr == nil &&
+			string(plaintext) == content {
+			return false, nil
+		}
+	}
+
+	// Encrypt rendered content
+	encrypted, err := encryption.Encrypt([]byte(content), e.passphrase)
+	if err != nil {
+		return false, fmt.Errorf("failed to encrypt export: %w", err)
+	}
+
+	return true, e.exporter.write(filePath, string(encrypted))
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/Gosayram/go-envsync/pkg/dotenv"
 )
 
 // Constants for export formats and limits
@@ -23,6 +26,9 @@ const (
 	// FormatYAML represents YAML file format.
 	FormatYAML = "yaml"
 
+	// FormatTemplate represents a custom format rendered from a Go template.
+	FormatTemplate = "template"
+
 	// MaxFileSize defines the maximum export file size in bytes.
 	MaxFileSize = 10 * 1024 * 1024 // 10MB
 
@@ -37,149 +43,437 @@ const (
 
 	// FormatPathParts defines the expected number of parts in format:path.
 	FormatPathParts = 2
+
+	// StdoutPath is the destination path that writes to standard output.
+	StdoutPath = "-"
+
+	// GroupSeparator separates the group prefix from the rest of a key in grouped .env output.
+	GroupSeparator = "_"
 )
 
+// Options defines optional behavior for the multi-format exporter.
+type Options struct {
+	// NoMetadata disables the metadata header in .env output and the metadata object in JSON/YAML output.
+	NoMetadata bool
+
+	// KeyPrefix is prepended to every key before writing. An empty prefix is a no-op.
+	KeyPrefix string
+
+	// GroupByPrefix groups .env output by the first underscore-delimited key segment,
+	// emitting a "# <GROUP>" comment before each group.
+	GroupByPrefix bool
+
+	// NestDelimiter, when set, rebuilds nested objects in JSON/YAML output by splitting keys
+	// on the delimiter, so DATABASE__HOST is written as {"DATABASE": {"HOST": ...}}.
+	// Use the delimiter the source was flattened with to round-trip nested documents.
+	NestDelimiter string
+
+	// Nest enables nesting with DefaultNestDelimiter when NestDelimiter is empty.
+	Nest bool
+
+	// ArrayKeys maps keys to a delimiter on which their values are split into arrays in
+	// JSON/YAML output, so HOSTS=a,b,c with {"HOSTS": ","} is written as ["a", "b", "c"].
+	// Elements are trimmed of surrounding whitespace. Keys are matched before KeyPrefix
+	// is applied; other formats keep the joined string.
+	ArrayKeys map[string]string
+
+	// InferTypes writes JSON/YAML values, including ArrayKeys elements, as booleans, numbers
+	// or null when they parse cleanly, instead of strings. Numbers must read back exactly as
+	// written, so values with leading zeros such as 007 stay strings; see inferValue.
+	InferTypes bool
+
+	// ComposeStandalone writes compose output as a bare YAML list instead of a list under
+	// an environment key.
+	ComposeStandalone bool
+
+	// K8sSecretName is the metadata.name of k8s-secret output; empty uses DefaultK8sSecretName.
+	K8sSecretName string
+
+	// K8sNamespace is the metadata.namespace of k8s-secret output; empty omits it.
+	K8sNamespace string
+}
+
 // MultiFormatExporter implements export functionality for multiple formats.
 type MultiFormatExporter struct {
 	outputDir string
+	options   Options
+	template  *exportTemplate
+
+	annotations map[string]KeyAnnotation
+	comments    map[string]string
 }
 
 // NewMultiFormatExporter creates a new multi-format exporter.
 func NewMultiFormatExporter(outputDir string) *MultiFormatExporter {
+	return NewMultiFormatExporterWithOptions(outputDir, Options{})
+}
+
+// NewMultiFormatExporterWithOptions creates a new multi-format exporter with custom options.
+func NewMultiFormatExporterWithOptions(outputDir string, options Options) *MultiFormatExporter {
 	if outputDir == "" {
 		outputDir = "."
 	}
 
 	return &MultiFormatExporter{
 		outputDir: outputDir,
+		options:   options,
 	}
 }
 
 // Export exports configuration to the specified format and destination.
-func (e *MultiFormatExporter) Export(_ context.Context, config map[string]string, destination string) error {
+func (e *MultiFormatExporter) Export(ctx context.Context, config map[string]string, destination string) error {
+	_, err := e.ExportChanged(ctx, config, destination)
+	return err
+}
+
+// ExportChanged exports configuration like Export and reports whether the destination was written.
+// An existing file with identical content is left untouched, preserving its modification time.
+func (e *MultiFormatExporter) ExportChanged(
+	_ context.Context, config map[string]string, destination string,
+) (bool, error) {
 	// Parse destination format and path
 	format, filePath, err := e.parseDestination(destination)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	// Ensure output directory exists
-	if err := e.ensureOutputDir(filePath); err != nil {
-		return err
+	// Render content in the requested format
+	content, err := e.render(config, format)
+	if err != nil {
+		return false, err
+	}
+
+	// Skip writing files whose content would not change
+	if existing, readErr := readExisting(filePath); readErr == nil && string(existing) == content {
+		return false, nil
+	}
+
+	return true, e.write(filePath, content)
+}
+
+// Render serializes configuration in the given format and returns the content without writing
+// it anywhere. Options, annotations, comments and the template apply as they do for Export.
+func (e *MultiFormatExporter) Render(config map[string]string, format string) ([]byte, error) {
+	content, err := e.render(config, strings.ToLower(format))
+	if err != nil {
+		return nil, err
 	}
+	return []byte(content), nil
+}
+
+// render serializes configuration in the given format.
+func (e *MultiFormatExporter) render(config map[string]string, format string) (string, error) {
+	// Apply key transformations
+	config = e.applyKeyPrefix(config)
 
-	// Export based on format
+	// Render based on format
 	switch format {
 	case FormatEnv:
-		return e.exportEnv(config, filePath)
+		return e.renderEnv(config)
 	case FormatJSON:
-		return e.exportJSON(config, filePath)
+		return e.renderJSON(config)
 	case FormatYAML:
-		return e.exportYAML(config, filePath)
+		return e.renderYAML(config)
+	case FormatProperties:
+		return e.renderProperties(config)
+	case FormatXML:
+		return e.renderXML(config)
+	case FormatCSV:
+		return e.renderCSV(config)
+	case FormatComposeEnv:
+		return e.renderComposeEnv(config)
+	case FormatK8sSecret:
+		return e.renderK8sSecret(config)
+	case FormatTemplate:
+		return e.renderTemplate(config)
 	default:
-		return fmt.Errorf("unsupported export format: %s", format)
+		return "", fmt.Errorf("unsupported export format: %s", format)
 	}
 }
 
+// write writes rendered content to a file or stdout, creating the output directory if needed.
+func (e *MultiFormatExporter) write(filePath, content string) error {
+	// Ensure output directory exists
+	if filePath != StdoutPath {
+		if err := e.ensureOutputDir(filePath); err != nil {
+			return err
+		}
+	}
+
+	return e.writeFile(filePath, content)
+}
+
+// ResolveDestination returns the format and file path a destination such as "json:config.json"
+// exports to, with relative paths resolved against the output directory. Nothing is written.
+func (e *MultiFormatExporter) ResolveDestination(destination string) (format, filePath string, err error) {
+	return e.parseDestination(destination)
+}
+
 // parseDestination parses the destination string to extract format and file path.
+// Only a supported format name before the first ':' is taken as the format: prefix, so
+// paths such as C:\out.env are not split. Without one, the format is inferred from the
+// file extension.
 func (e *MultiFormatExporter) parseDestination(destination string) (format, filePath string, err error) {
 	parts := strings.SplitN(destination, ":", FormatPathParts)
-	if len(parts) != FormatPathParts {
-		return "", "", fmt.Errorf("invalid destination format, expected 'format:path', got: %s", destination)
+	if len(parts) == FormatPathParts && isSupportedFormat(strings.ToLower(parts[0])) {
+		format = strings.ToLower(parts[0])
+		filePath = parts[1]
+	} else {
+		filePath = destination
+		if format = FormatFromPath(filePath); format == "" {
+			if len(parts) == FormatPathParts {
+				return "", "", fmt.Errorf("unsupported export format: %s (supported: %s)",
+					parts[0], strings.Join(GetSupportedFormats(), ", "))
+			}
+			return "", "", fmt.Errorf("cannot infer export format of %s, expected 'format:path' or a "+
+				"path ending in .env, .json, .yaml, .yml, .properties, .xml or .csv", destination)
+		}
 	}
 
-	format = strings.ToLower(parts[0])
-	filePath = parts[1]
-
 	// Resolve relative paths
-	if !filepath.IsAbs(filePath) {
+	if filePath != StdoutPath && !filepath.IsAbs(filePath) {
 		filePath = filepath.Join(e.outputDir, filePath)
 	}
 
 	return format, filePath, nil
 }
 
+// FormatFromPath returns the export format matching the extension of a file path, or an
+// empty string when the extension is not recognized. Files named .env or .env.* are .env files.
+func FormatFromPath(filePath string) string {
+	base := filepath.Base(filePath)
+	if base == ".env" || strings.HasPrefix(base, ".env.") {
+		return FormatEnv
+	}
+
+	switch strings.ToLower(filepath.Ext(base)) {
+	case ".env":
+		return FormatEnv
+	case ".json":
+		return FormatJSON
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".properties":
+		return FormatProperties
+	case ".xml":
+		return FormatXML
+	case ".csv":
+		return FormatCSV
+	default:
+		return ""
+	}
+}
+
 // ensureOutputDir ensures the output directory exists.
 func (e *MultiFormatExporter) ensureOutputDir(filePath string) error {
 	dir := filepath.Dir(filePath)
 	return os.MkdirAll(dir, DefaultDirPermissions)
 }
 
-// exportEnv exports configuration to .env format.
-func (e *MultiFormatExporter) exportEnv(config map[string]string, filePath string) error {
+// renderEnv renders configuration in .env format.
+func (e *MultiFormatExporter) renderEnv(config map[string]string) (string, error) {
 	var content strings.Builder
 
 	// Add header comment
-	content.WriteString("# Environment configuration exported by go-envsync\n")
-	content.WriteString("# Generated automatically - do not edit manually\n\n")
+	if !e.options.NoMetadata {
+		content.WriteString("# Environment configuration exported by go-envsync\n")
+		content.WriteString("# Generated automatically - do not edit manually\n\n")
+	}
 
-	// Write key-value pairs
-	for key, value := range config {
-		// Escape value if necessary
-		escapedValue := e.escapeEnvValue(value)
-		content.WriteString(fmt.Sprintf("%s=%s\n", key, escapedValue))
+	// Write key-value pairs in sorted order
+	if e.options.GroupByPrefix {
+		e.writeGroupedEnv(&content, config)
+		return content.String(), nil
 	}
 
-	return e.writeFile(filePath, content.String())
+	for _, key := range sortedKeys(config) {
+		e.writeEnvLine(&content, key, config[key])
+	}
+
+	return content.String(), nil
 }
 
-// exportJSON exports configuration to JSON format.
-func (e *MultiFormatExporter) exportJSON(config map[string]string, filePath string) error {
+// writeGroupedEnv writes key-value pairs grouped by their first underscore-delimited segment.
+// Keys without a separator are written first, without a group header.
+func (e *MultiFormatExporter) writeGroupedEnv(content *strings.Builder, config map[string]string) {
+	currentGroup := ""
+	for _, key := range sortedKeysByGroup(config) {
+		group := keyGroup(key)
+		if group != currentGroup {
+			if content.Len() > 0 && !strings.HasSuffix(content.String(), "\n\n") {
+				content.WriteString("\n")
+			}
+			content.WriteString(fmt.Sprintf("# %s\n", group))
+			currentGroup = group
+		}
+
+		e.writeEnvLine(content, key, config[key])
+	}
+}
+
+// sortedKeysByGroup returns the configuration keys sorted by group, then by key.
+// Ungrouped keys sort before all groups.
+func sortedKeysByGroup(config map[string]string) []string {
+	keys := sortedKeys(config)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keyGroup(keys[i]) < keyGroup(keys[j])
+	})
+	return keys
+}
+
+// keyGroup returns the first underscore-delimited segment of a key, or an empty string
+// when the key has no separator.
+func keyGroup(key string) string {
+	index := strings.Index(key, GroupSeparator)
+	if index <= 0 {
+		return ""
+	}
+	return key[:index]
+}
+
+// writeEnvLine writes a single escaped key-value pair in .env format, preceded by its annotation
+// and comment.
+func (e *MultiFormatExporter) writeEnvLine(content *strings.Builder, key, value string) {
+	e.writeAnnotation(content, key)
+	e.writeComment(content, key)
+	content.WriteString(fmt.Sprintf("%s=%s\n", key, e.escapeEnvValue(value)))
+}
+
+// renderJSON renders configuration in JSON format.
+func (e *MultiFormatExporter) renderJSON(config map[string]string) (string, error) {
+	configValue, err := e.structuredConfig(config)
+	if err != nil {
+		return "", err
+	}
+
 	// Create output structure
 	output := struct {
-		Metadata map[string]string `json:"metadata"`
-		Config   map[string]string `json:"config"`
+		Metadata map[string]string `json:"metadata,omitempty"`
+		Config   interface{}       `json:"config"`
 	}{
-		Metadata: map[string]string{
-			"exported_by": "go-envsync",
-			"format":      "json",
-		},
-		Config: config,
+		Metadata: e.metadata(FormatJSON),
+		Config:   e.withJSONComments(config, configValue),
 	}
 
 	// Marshal to JSON with indentation
 	data, err := json.MarshalIndent(output, "", strings.Repeat(" ", JSONIndentSpaces))
 	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+		return "", fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	return e.writeFile(filePath, string(data))
+	return string(data), nil
 }
 
-// exportYAML exports configuration to YAML format.
-func (e *MultiFormatExporter) exportYAML(config map[string]string, filePath string) error {
+// renderYAML renders configuration in YAML format.
+func (e *MultiFormatExporter) renderYAML(config map[string]string) (string, error) {
+	configValue, err := e.structuredConfig(config)
+	if err != nil {
+		return "", err
+	}
+	if configValue, err = e.withYAMLComments(config, configValue); err != nil {
+		return "", fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+
 	// Create output structure
 	output := struct {
-		Metadata map[string]string `yaml:"metadata"`
-		Config   map[string]string `yaml:"config"`
+		Metadata map[string]string `yaml:"metadata,omitempty"`
+		Config   interface{}       `yaml:"config"`
 	}{
-		Metadata: map[string]string{
-			"exported_by": "go-envsync",
-			"format":      "yaml",
-		},
-		Config: config,
+		Metadata: e.metadata(FormatYAML),
+		Config:   configValue,
 	}
 
 	// Marshal to YAML
 	data, err := yaml.Marshal(output)
 	if err != nil {
-		return fmt.Errorf("failed to marshal YAML: %w", err)
+		return "", fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// structuredConfig returns the configuration for JSON/YAML output, with ArrayKeys split
+// into arrays, values typed when InferTypes is set and nested when Nest or NestDelimiter is set.
+func (e *MultiFormatExporter) structuredConfig(config map[string]string) (interface{}, error) {
+	delimiter := e.nestDelimiter()
+	if delimiter == "" && len(e.options.ArrayKeys) == 0 && !e.options.InferTypes {
+		return config, nil
+	}
+
+	values := make(map[string]interface{}, len(config))
+	for key, value := range config {
+		arrayDelimiter, isArray := e.options.ArrayKeys[strings.TrimPrefix(key, e.options.KeyPrefix)]
+		switch {
+		case isArray && e.options.InferTypes:
+			values[key] = inferArray(splitArray(value, arrayDelimiter))
+		case isArray:
+			values[key] = splitArray(value, arrayDelimiter)
+		case e.options.InferTypes:
+			values[key] = inferValue(value)
+		default:
+			values[key] = value
+		}
 	}
 
-	return e.writeFile(filePath, string(data))
+	if delimiter == "" {
+		return values, nil
+	}
+	return nestConfig(values, delimiter)
+}
+
+// splitArray splits a value into trimmed elements. An empty value is an empty array.
+func splitArray(value, delimiter string) []string {
+	if strings.TrimSpace(value) == "" {
+		return []string{}
+	}
+
+	elements := strings.Split(value, delimiter)
+	for i, element := range elements {
+		elements[i] = strings.TrimSpace(element)
+	}
+	return elements
+}
+
+// applyKeyPrefix returns a copy of the configuration with the configured prefix added to every key.
+// Since every key receives the same prefix, distinct keys cannot collide.
+func (e *MultiFormatExporter) applyKeyPrefix(config map[string]string) map[string]string {
+	if e.options.KeyPrefix == "" {
+		return config
+	}
+
+	prefixed := make(map[string]string, len(config))
+	for key, value := range config {
+		prefixed[e.options.KeyPrefix+key] = value
+	}
+
+	return prefixed
+}
+
+// metadata returns the metadata block for structured formats, or nil when disabled.
+func (e *MultiFormatExporter) metadata(format string) map[string]string {
+	if e.options.NoMetadata {
+		return nil
+	}
+
+	return map[string]string{
+		"exported_by": "go-envsync",
+		"format":      format,
+	}
 }
 
 // escapeEnvValue escapes a value for .env format.
 func (e *MultiFormatExporter) escapeEnvValue(value string) string {
-	// If value contains spaces or special characters, quote it
-	if strings.ContainsAny(value, " \t\n\r\"'\\") {
-		// Escape quotes and backslashes
-		escaped := strings.ReplaceAll(value, "\\", "\\\\")
-		escaped = strings.ReplaceAll(escaped, "\"", "\\\"")
-		return fmt.Sprintf("%q", escaped)
+	return dotenv.EscapeValue(value)
+}
+
+// readExisting reads the current content of an export destination. Stdout has no content.
+func readExisting(filePath string) ([]byte, error) {
+	if filePath == StdoutPath {
+		return nil, os.ErrNotExist
 	}
 
-	return value
+	// #nosec G304 - filePath is the configured export destination
+	return os.ReadFile(filePath)
 }
 
 // writeFile writes content to a file with size validation.
@@ -189,6 +483,14 @@ func (e *MultiFormatExporter) writeFile(filePath, content string) error {
 		return fmt.Errorf("export content too large: %d bytes > %d bytes", len(content), MaxFileSize)
 	}
 
+	// Write to stdout if requested
+	if filePath == StdoutPath {
+		if _, err := os.Stdout.WriteString(content); err != nil {
+			return fmt.Errorf("failed to write to stdout: %w", err)
+		}
+		return nil
+	}
+
 	// Write file
 	if err := os.WriteFile(filePath, []byte(content), DefaultFilePermissions); err != nil {
 		return fmt.Errorf("failed to write file %s: %w", filePath, err)
@@ -199,5 +501,18 @@ func (e *MultiFormatExporter) writeFile(filePath, content string) error {
 
 // GetSupportedFormats returns a list of supported export formats.
 func GetSupportedFormats() []string {
-	return []string{FormatEnv, FormatJSON, FormatYAML}
+	return []string{
+		FormatEnv, FormatJSON, FormatYAML, FormatProperties, FormatXML, FormatCSV, FormatComposeEnv,
+		FormatK8sSecret, FormatTemplate,
+	}
+}
+
+// isSupportedFormat reports whether format is one of GetSupportedFormats.
+func isSupportedFormat(format string) bool {
+	for _, supported := range GetSupportedFormats() {
+		if format == supported {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,64 @@
+package exporter
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// resolvedPath returns filePath resolved against outputDir as the exporter does, which
+// leaves C:\ paths alone on Windows only.
+func resolvedPath(outputDir, filePath string) string {
+	if filepath.IsAbs(filePath) {
+		return filePath
+	}
+	return filepath.Join(outputDir, filePath)
+}
+
+func TestResolveDestination(t *testing.T) {
+	e := NewMultiFormatExporter("out")
+
+	tests := []struct {
+		destination string
+		format      string
+		filePath    string
+	}{
+		{"json:config.json", FormatJSON, filepath.Join("out", "config.json")},
+		{"YAML:config.txt", FormatYAML, filepath.Join("out", "config.txt")},
+		{"env:-", FormatEnv, StdoutPath},
+		{"config.properties", FormatProperties, filepath.Join("out", "config.properties")},
+		{".env.production", FormatEnv, filepath.Join("out", ".env.production")},
+		{`C:\out.env`, FormatEnv, resolvedPath("out", `C:\out.env`)},
+		{`env:C:\out.txt`, FormatEnv, resolvedPath("out", `C:\out.txt`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.destination, func(t *testing.T) {
+			format, filePath, err := e.ResolveDestination(tt.destination)
+			if err != nil {
+				t.Fatalf("ResolveDestination() error = %v", err)
+			}
+			if format != tt.format || filePath != tt.filePath {
+				t.Errorf("ResolveDestination() = %s, %s; want %s, %s", format, filePath, tt.format, tt.filePath)
+			}
+		})
+	}
+}
+
+func TestResolveDestinationErrors(t *testing.T) {
+	e := NewMultiFormatExporter(".")
+
+	tests := []struct {
+		destination string
+		want        string
+	}{
+		{"toml:config.toml", "unsupported export format: toml"},
+		{"config.txt", "cannot infer export format"},
+	}
+
+	for _, tt := range tests {
+		if _, _, err := e.ResolveDestination(tt.destination); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ResolveDestination(%s) error = %v, want %q", tt.destination, err, tt.want)
+		}
+	}
+}
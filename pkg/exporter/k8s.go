@@ -0,0 +1,116 @@
+package exporter
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Constants for Kubernetes Secret export
+const (
+	// FormatK8sSecret represents a Kubernetes v1 Secret manifest.
+	FormatK8sSecret = "k8s-secret"
+
+	// DefaultK8sSecretName is the Secret name used when Options.K8sSecretName is empty.
+	DefaultK8sSecretName = "go-envsync"
+
+	// K8sSecretType is the type of exported Secrets.
+	K8sSecretType = "Opaque"
+
+	// K8sManagedByLabel is the standard label recording the tool managing a resource.
+	K8sManagedByLabel = "app.kubernetes.io/managed-by"
+
+	// MaxK8sNameLength is the maximum length of Secret names and data keys.
+	MaxK8sNameLength = 253
+)
+
+// Validation patterns for Kubernetes names and Secret data keys
+var (
+	k8sSecretKeyPattern = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+	k8sNamePattern      = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// k8sSecret is the manifest structure of a Kubernetes Secret, in conventional field order.
+type k8sSecret struct {
+	APIVersion string            `yaml:"apiVersion"`
+	Kind       string            `yaml:"kind"`
+	Metadata   k8sObjectMeta     `yaml:"metadata"`
+	Type       string            `yaml:"type"`
+	Data       map[string]string `yaml:"data"`
+}
+
+// k8sObjectMeta is the metadata of a Kubernetes object.
+type k8sObjectMeta struct {
+	Name      string            `yaml:"name"`
+	Namespace string            `yaml:"namespace,omitempty"`
+	Labels    map[string]string `yaml:"labels,omitempty"`
+}
+
+// renderK8sSecret renders configuration as a v1 Secret manifest with base64-encoded data.
+// Keys must be valid Secret data keys: alphanumerics, '-', '_' and '.'.
+func (e *MultiFormatExporter) renderK8sSecret(config map[string]string) (string, error) {
+	name := e.options.K8sSecretName
+	if name == "" {
+		name = DefaultK8sSecretName
+	}
+	if err := validateK8sName("secret name", name); err != nil {
+		return "", err
+	}
+	if e.options.K8sNamespace != "" {
+		if err := validateK8sName("namespace", e.options.K8sNamespace); err != nil {
+			return "", err
+		}
+	}
+
+	// Encode values, rejecting keys Kubernetes would refuse
+	var invalid []string
+	data := make(map[string]string, len(config))
+	for _, key := range sortedKeys(config) {
+		if len(key) > MaxK8sNameLength || !k8sSecretKeyPattern.MatchString(key) {
+			invalid = append(invalid, key)
+			continue
+		}
+		data[key] = base64.StdEncoding.EncodeToString([]byte(config[key]))
+	}
+	if len(invalid) > 0 {
+		return "", fmt.Errorf("invalid Secret data keys (allowed: alphanumerics, '-', '_', '.'): %s",
+			strings.Join(invalid, ", "))
+	}
+
+	secret := k8sSecret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata: k8sObjectMeta{
+			Name:      name,
+			Namespace: e.options.K8sNamespace,
+		},
+		Type: K8sSecretType,
+		Data: data,
+	}
+	if !e.options.NoMetadata {
+		secret.Metadata.Labels = map[string]string{K8sManagedByLabel: "go-envsync"}
+	}
+
+	var content strings.Builder
+	encoder := yaml.NewEncoder(&content)
+	encoder.SetIndent(manifestIndent)
+	if err := encoder.Encode(secret); err != nil {
+		return "", fmt.Errorf("failed to marshal Secret manifest: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal Secret manifest: %w", err)
+	}
+
+	return content.String(), nil
+}
+
+// validateK8sName checks a Kubernetes object name against the DNS subdomain rules.
+func validateK8sName(what, name string) error {
+	if len(name) > MaxK8sNameLength || !k8sNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid %s %q: must be a lowercase DNS subdomain", what, name)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package exporter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Constants for nested export
+const (
+	// DefaultNestDelimiter is the delimiter used by Options.Nest. It matches the default
+	// delimiter the local provider flattens nested JSON/YAML documents with.
+	DefaultNestDelimiter = "__"
+)
+
+// nestDelimiter returns the delimiter nested JSON/YAML output is split on, or "" when
+// nesting is disabled.
+func (e *MultiFormatExporter) nestDelimiter() string {
+	if e.options.NestDelimiter != "" {
+		return e.options.NestDelimiter
+	}
+	if e.options.Nest {
+		return DefaultNestDelimiter
+	}
+	return ""
+}
+
+// nestConfig rebuilds nested objects from keys joined with delimiter, reversing the
+// flattening applied when JSON/YAML documents are loaded. It fails when a key is both
+// a value and a parent of other keys, such as APP and APP__NAME.
+func nestConfig(config map[string]interface{}, delimiter string) (map[string]interface{}, error) {
+	nested := make(map[string]interface{})
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := nestValue(nested, strings.Split(key, delimiter), config[key]); err != nil {
+			return nil, fmt.Errorf("cannot nest key %s: %w", key, err)
+		}
+	}
+
+	return nested, nil
+}
+
+// nestValue stores value under the path of key segments, creating intermediate objects.
+func nestValue(node map[string]interface{}, segments []string, value interface{}) error {
+	for _, segment := range segments[:len(segments)-1] {
+		child, exists := node[segment]
+		if !exists {
+			next := make(map[string]interface{})
+			node[segment] = next
+			node = next
+			continue
+		}
+
+		parent, isObject := child.(map[string]interface{})
+		if !isObject {
+			return fmt.Errorf("parent %s already holds a value", segment)
+		}
+		node = parent
+	}
+
+	last := segments[len(segments)-1]
+	if _, exists := node[last]; exists {
+		return fmt.Errorf("%s already holds nested keys", last)
+	}
+	node[last] = value
+	return nil
+}
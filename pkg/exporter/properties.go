@@ -0,0 +1,81 @@
+package exporter
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// Constants for properties export
+const (
+	// FormatProperties represents Java .properties file format.
+	FormatProperties = "properties"
+
+	// propertiesMaxPrintable is the highest character written without a \uXXXX escape.
+	propertiesMaxPrintable = 0x7e
+
+	// propertiesMinPrintable is the lowest character written without a \uXXXX escape.
+	propertiesMinPrintable = 0x20
+)
+
+// renderProperties renders configuration in Java .properties format.
+func (e *MultiFormatExporter) renderProperties(config map[string]string) (string, error) {
+	var content strings.Builder
+
+	// Add header comment
+	if !e.options.NoMetadata {
+		content.WriteString("# Properties exported by go-envsync\n")
+		content.WriteString("# Generated automatically - do not edit manually\n\n")
+	}
+
+	// Write key-value pairs in sorted order
+	for _, key := range sortedKeys(config) {
+		content.WriteString(fmt.Sprintf("%s=%s\n", escapeProperty(key, true), escapeProperty(config[key], false)))
+	}
+
+	return content.String(), nil
+}
+
+// escapeProperty escapes a key or value using the rules of java.util.Properties.store:
+// separators, comment characters and backslashes are escaped, control characters use
+// \t, \n, \r and \f, and characters outside printable ASCII become \uXXXX escapes.
+// In keys every space is escaped; in values only a leading space is.
+func escapeProperty(text string, isKey bool) string {
+	var builder strings.Builder
+
+	for i, r := range text {
+		switch r {
+		case '\\', '=', ':', '#', '!':
+			builder.WriteByte('\\')
+			builder.WriteRune(r)
+		case ' ':
+			if isKey || i == 0 {
+				builder.WriteByte('\\')
+			}
+			builder.WriteRune(r)
+		case '\t':
+			builder.WriteString(`\t`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\f':
+			builder.WriteString(`\f`)
+		default:
+			if r < propertiesMinPrintable || r > propertiesMaxPrintable {
+				writeUnicodeEscape(&builder, r)
+				continue
+			}
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// writeUnicodeEscape writes a rune as one or two (surrogate pair) \uXXXX escapes.
+func writeUnicodeEscape(builder *strings.Builder, r rune) {
+	for _, unit := range utf16.Encode([]rune{r}) {
+		builder.WriteString(fmt.Sprintf(`\u%04X`, unit))
+	}
+}
@@ -0,0 +1,163 @@
+package exporter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Constants for template export
+const (
+	// TemplateLineName is the name of the per-key line template.
+	TemplateLineName = "line"
+
+	// TemplateHeaderName is the name of the header template.
+	TemplateHeaderName = "header"
+
+	// TemplateFooterName is the name of the footer template.
+	TemplateFooterName = "footer"
+)
+
+// TemplateEntry is the data passed to the per-key line template.
+type TemplateEntry struct {
+	// Key is the configuration key.
+	Key string
+
+	// Value is the configuration value.
+	Value string
+
+	// Index is the zero-based position of the key in sorted order.
+	Index int
+}
+
+// TemplateDocument is the data passed to the header and footer templates.
+type TemplateDocument struct {
+	// Keys contains all configuration keys in sorted order.
+	Keys []string
+
+	// Count is the number of configuration keys.
+	Count int
+
+	// Config contains the full configuration map.
+	Config map[string]string
+}
+
+// exportTemplate holds the parsed templates for template-driven export.
+type exportTemplate struct {
+	line   *template.Template
+	header *template.Template
+	footer *template.Template
+}
+
+// TemplateFuncs returns the functions available to export templates.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"quote":   strconv.Quote,
+		"squote":  shellSingleQuote,
+		"upper":   strings.ToUpper,
+		"lower":   strings.ToLower,
+		"trim":    strings.TrimSpace,
+		"replace": func(old, replacement, s string) string { return strings.ReplaceAll(s, old, replacement) },
+	}
+}
+
+// shellSingleQuote wraps s in POSIX shell single quotes. A single quote cannot be escaped
+// inside single quotes, so each one closes the quoting, adds an escaped quote and reopens it.
+func shellSingleQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// SetTemplate configures the templates used by the template export format.
+// The line template is rendered once per key in sorted order; header and footer are optional.
+func (e *MultiFormatExporter) SetTemplate(line, header, footer string) error {
+	if strings.TrimSpace(line) == "" {
+		return fmt.Errorf("line template cannot be empty")
+	}
+
+	tmpl := &exportTemplate{}
+
+	var err error
+	if tmpl.line, err = parseTemplate(TemplateLineName, line); err != nil {
+		return err
+	}
+
+	if header != "" {
+		if tmpl.header, err = parseTemplate(TemplateHeaderName, header); err != nil {
+			return err
+		}
+	}
+
+	if footer != "" {
+		if tmpl.footer, err = parseTemplate(TemplateFooterName, footer); err != nil {
+			return err
+		}
+	}
+
+	e.template = tmpl
+	return nil
+}
+
+// parseTemplate parses a single export template with the export function map.
+func parseTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(TemplateFuncs()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+
+	return tmpl, nil
+}
+
+// renderTemplate renders configuration using the configured templates.
+func (e *MultiFormatExporter) renderTemplate(config map[string]string) (string, error) {
+	if e.template == nil {
+		return "", fmt.Errorf("no export template configured")
+	}
+
+	keys := sortedKeys(config)
+	document := TemplateDocument{
+		Keys:   keys,
+		Count:  len(keys),
+		Config: config,
+	}
+
+	var content strings.Builder
+
+	// Render header
+	if e.template.header != nil {
+		if err := e.template.header.Execute(&content, document); err != nil {
+			return "", fmt.Errorf("failed to render header template: %w", err)
+		}
+		content.WriteString("\n")
+	}
+
+	// Render one line per key
+	for i, key := range keys {
+		entry := TemplateEntry{Key: key, Value: config[key], Index: i}
+		if err := e.template.line.Execute(&content, entry); err != nil {
+			return "", fmt.Errorf("failed to render line template for key %s: %w", key, err)
+		}
+		content.WriteString("\n")
+	}
+
+	// Render footer
+	if e.template.footer != nil {
+		if err := e.template.footer.Execute(&content, document); err != nil {
+			return "", fmt.Errorf("failed to render footer template: %w", err)
+		}
+		content.WriteString("\n")
+	}
+
+	return content.String(), nil
+}
+
+// sortedKeys returns the configuration keys in sorted order.
+func sortedKeys(config map[string]string) []string {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
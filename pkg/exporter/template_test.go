@@ -0,0 +1,116 @@
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// exportTemplateFile exports config with the template format and returns the written file.
+func exportTemplateFile(t *testing.T, e *MultiFormatExporter, config map[string]string) (string, error) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "out.txt")
+	if err := e.Export(context.Background(), config, FormatTemplate+":"+path); err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	return string(content), nil
+}
+
+func TestRenderTemplate(t *testing.T) {
+	config := map[string]string{
+		"ZETA":  "last",
+		"ALPHA": `say "hi"`,
+		"MID":   "middle",
+	}
+
+	tests := []struct {
+		name   string
+		line   string
+		header string
+		footer string
+		want   string
+	}{
+		{
+			name: "line only",
+			line: "{{.Key}}: {{.Value | quote}}",
+			want: "ALPHA: \"say \\\"hi\\\"\"\nMID: \"middle\"\nZETA: \"last\"\n",
+		},
+		{
+			name:   "header and footer",
+			line:   "{{.Index}} {{.Key | lower}}={{.Value | upper}}",
+			header: "# {{.Count}} keys",
+			footer: "# end {{index .Keys 0}}",
+			want:   "# 3 keys\n0 alpha=SAY \"HI\"\n1 mid=MIDDLE\n2 zeta=LAST\n# end ALPHA\n",
+		},
+		{
+			name: "squote and replace",
+			line: `{{.Key | replace "A" "_"}}={{.Value | squote}}`,
+			want: "_LPH_='say \"hi\"'\nMID='middle'\nZET_='last'\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewMultiFormatExporter("")
+			if err := e.SetTemplate(tt.line, tt.header, tt.footer); err != nil {
+				t.Fatalf("SetTemplate() error = %v", err)
+			}
+
+			// Export repeatedly to catch map iteration order leaking into the output
+			for i := 0; i < 5; i++ {
+				got, err := exportTemplateFile(t, e, config)
+				if err != nil {
+					t.Fatalf("Export() error = %v", err)
+				}
+				if got != tt.want {
+					t.Fatalf("Export() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestShellSingleQuote(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "", want: "''"},
+		{input: "plain", want: "'plain'"},
+		{input: "it's", want: `'it'\''s'`},
+		{input: "''", want: `''\'''\'''`},
+		{input: `$HOME "x" \n`, want: `'$HOME "x" \n'`},
+	}
+
+	for _, tt := range tests {
+		if got := shellSingleQuote(tt.input); got != tt.want {
+			t.Errorf("shellSingleQuote(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRenderTemplateErrors(t *testing.T) {
+	e := NewMultiFormatExporter("")
+	if _, err := exportTemplateFile(t, e, map[string]string{"A": "1"}); err == nil {
+		t.Error("Export() without a template succeeded, want error")
+	}
+	if err := e.SetTemplate("  ", "", ""); err == nil {
+		t.Error("SetTemplate() with an empty line template succeeded, want error")
+	}
+	if err := e.SetTemplate("{{.Key", "", ""); err == nil {
+		t.Error("SetTemplate() with a malformed template succeeded, want error")
+	}
+	if err := e.SetTemplate("{{.Missing}}", "", ""); err != nil {
+		t.Fatalf("SetTemplate() error = %v", err)
+	}
+	if _, err := exportTemplateFile(t, e, map[string]string{"A": "1"}); err == nil {
+		t.Error("Export() with an unknown field succeeded, want error")
+	}
+}
@@ -0,0 +1,55 @@
+package exporter
+
+import (
+	"math"
+	"strconv"
+)
+
+// Constants for type inference
+const (
+	// nullLiteral is the value inferred as null by Options.InferTypes.
+	nullLiteral = "null"
+
+	// floatBitSize is the precision of numbers inferred as floats.
+	floatBitSize = 64
+)
+
+// inferValue returns the JSON/YAML value of a string for Options.InferTypes: true and false
+// become booleans and null becomes null. Numbers become numbers only when they read back
+// exactly as written, so values with leading zeros (007), a plus sign, trailing zeros
+// (1.10), exponents or NaN/Inf stay strings, keeping zip codes and versions intact.
+// Everything else stays a string.
+func inferValue(value string) interface{} {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	case nullLiteral:
+		return nil
+	}
+
+	if integer, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if strconv.FormatInt(integer, 10) == value {
+			return integer
+		}
+		return value
+	}
+
+	if float, err := strconv.ParseFloat(value, floatBitSize); err == nil && !math.IsInf(float, 0) && !math.IsNaN(float) {
+		if strconv.FormatFloat(float, 'f', -1, floatBitSize) == value {
+			return float
+		}
+	}
+
+	return value
+}
+
+// inferArray returns the inferred values of array elements.
+func inferArray(elements []string) []interface{} {
+	values := make([]interface{}, len(elements))
+	for i, element := range elements {
+		values[i] = inferValue(element)
+	}
+	return values
+}
@@ -0,0 +1,53 @@
+package exporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Constants for XML export
+const (
+	// FormatXML represents XML file format.
+	FormatXML = "xml"
+)
+
+// xmlDocument is the root element of XML exports.
+type xmlDocument struct {
+	XMLName    xml.Name   `xml:"config"`
+	ExportedBy string     `xml:"exported_by,attr,omitempty"`
+	Format     string     `xml:"format,attr,omitempty"`
+	Entries    []xmlEntry `xml:"entry"`
+}
+
+// xmlEntry is a single configuration entry in XML exports.
+type xmlEntry struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// renderXML renders configuration in XML format: a root <config> element with one
+// <entry key="..."> child per key in sorted order. Metadata is written as root attributes.
+func (e *MultiFormatExporter) renderXML(config map[string]string) (string, error) {
+	document := xmlDocument{
+		Entries: make([]xmlEntry, 0, len(config)),
+	}
+
+	// Add metadata attributes
+	if metadata := e.metadata(FormatXML); metadata != nil {
+		document.ExportedBy = metadata["exported_by"]
+		document.Format = metadata["format"]
+	}
+
+	for _, key := range sortedKeys(config) {
+		document.Entries = append(document.Entries, xmlEntry{Key: key, Value: config[key]})
+	}
+
+	// Marshal to XML with indentation
+	data, err := xml.MarshalIndent(document, "", strings.Repeat(" ", JSONIndentSpaces))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal XML: %w", err)
+	}
+
+	return xml.Header + string(data) + "\n", nil
+}
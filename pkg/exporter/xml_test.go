@@ -0,0 +1,69 @@
+package exporter
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestRenderXMLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{name: "angle brackets", key: "TAGS", value: "<b>bold</b> > plain"},
+		{name: "ampersand", key: "QUERY", value: "a=1&b=2&amp;"},
+		{name: "double quotes", key: "QUOTED", value: `say "hi"`},
+		{name: "single quotes", key: "APOS", value: "it's"},
+		{name: "special key", key: `K<&>"'`, value: "v"},
+		{name: "multiline", key: "PEM", value: "line1\nline2\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]string{tt.key: tt.value, "PLAIN": "ok"}
+
+			data, err := NewMultiFormatExporter("").render(config, FormatXML)
+			if err != nil {
+				t.Fatalf("render() error = %v", err)
+			}
+
+			var document xmlDocument
+			if err := xml.Unmarshal([]byte(data), &document); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v\n%s", err, data)
+			}
+			if document.ExportedBy != "go-envsync" || document.Format != FormatXML {
+				t.Errorf("metadata = %q, %q, want go-envsync, %s", document.ExportedBy, document.Format, FormatXML)
+			}
+
+			got := make(map[string]string, len(document.Entries))
+			for _, entry := range document.Entries {
+				got[entry.Key] = entry.Value
+			}
+			if len(got) != len(config) {
+				t.Fatalf("decoded %d entries, want %d", len(got), len(config))
+			}
+			for key, want := range config {
+				if got[key] != want {
+					t.Errorf("entry %q = %q, want %q", key, got[key], want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderXMLNoMetadata(t *testing.T) {
+	e := NewMultiFormatExporterWithOptions("", Options{NoMetadata: true})
+	data, err := e.render(map[string]string{"A": "1"}, FormatXML)
+	if err != nil {
+		t.Fatalf("render() error = %v", err)
+	}
+
+	var document xmlDocument
+	if err := xml.Unmarshal([]byte(data), &document); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if document.ExportedBy != "" || document.Format != "" {
+		t.Errorf("metadata = %q, %q, want none", document.ExportedBy, document.Format)
+	}
+}
@@ -0,0 +1,314 @@
+// Package archive provides a provider for zip and tar archives of configuration files for go-envsync.
+// Every configuration file in the archive is parsed according to its extension and the results
+// are merged in sorted entry order, so later entries override earlier ones.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// Constants for archive provider
+const (
+	// ProviderName is the name of the archive provider.
+	ProviderName = "archive"
+
+	// MaxArchiveSize defines the maximum size in bytes of an archive file.
+	MaxArchiveSize = 50 * 1024 * 1024 // 50MB
+
+	// MaxDecompressedSize defines the maximum total size in bytes of the configuration
+	// files read from one archive, guarding against decompression bombs.
+	MaxDecompressedSize = 50 * 1024 * 1024 // 50MB
+
+	// MaxEntries defines the maximum number of configuration files in one archive.
+	MaxEntries = 1000
+
+	// ExtensionZip is the extension of zip archives.
+	ExtensionZip = ".zip"
+
+	// ExtensionTar is the extension of uncompressed tar archives.
+	ExtensionTar = ".tar"
+
+	// ExtensionTarGz is the extension of gzip-compressed tar archives.
+	ExtensionTarGz = ".tar.gz"
+
+	// ExtensionTgz is the short extension of gzip-compressed tar archives.
+	ExtensionTgz = ".tgz"
+
+	// macOSMetadataDir is the directory of resource forks added by the macOS archiver.
+	macOSMetadataDir = "__MACOSX/"
+)
+
+// entry is a configuration file read from an archive.
+type entry struct {
+	name string
+	data []byte
+}
+
+// Provider implements a provider that loads configuration files bundled in an archive.
+type Provider struct {
+	basePath string
+}
+
+// NewProvider creates a new archive provider with the current directory as base path.
+func NewProvider() *Provider {
+	return NewProviderWithBase(".")
+}
+
+// NewProviderWithBase creates a new archive provider with the specified base path.
+func NewProviderWithBase(basePath string) *Provider {
+	if basePath == "" {
+		basePath = "."
+	}
+
+	return &Provider{
+		basePath: basePath,
+	}
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// Load reads every configuration file in the archive and merges them in sorted entry order.
+func (p *Provider) Load(ctx context.Context, source string) (map[string]string, error) {
+	filePath := p.resolveFilePath(source)
+
+	// Read configuration entries
+	var entries []entry
+	var err error
+	if isZip(filePath) {
+		entries, err = readZip(ctx, filePath)
+	} else {
+		entries, err = readTar(ctx, filePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive %s: %w", filePath, err)
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("archive %s contains no configuration files", filePath)
+	}
+
+	// Parse and merge entries in sorted order
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+
+	config := make(map[string]string)
+	for _, file := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		values, err := local.ParseDocument(file.data, local.DetectFormat(file.name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s in archive %s: %w", file.name, filePath, err)
+		}
+		for key, value := range values {
+			config[key] = value
+		}
+	}
+
+	return config, nil
+}
+
+// Validate validates the source before loading.
+func (p *Provider) Validate(source string) error {
+	if strings.TrimSpace(source) == "" {
+		return fmt.Errorf("source cannot be empty")
+	}
+
+	filePath := p.resolveFilePath(source)
+	if !isZip(filePath) && !isTar(filePath) {
+		return fmt.Errorf("unsupported archive type: %s (supported: %s, %s, %s, %s)",
+			filePath, ExtensionZip, ExtensionTar, ExtensionTarGz, ExtensionTgz)
+	}
+
+	fileInfo, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return &local.NotFoundError{Path: filePath}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat file %s: %w", filePath, err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return fmt.Errorf("source is not a regular file: %s", filePath)
+	}
+
+	if fileInfo.Size() > MaxArchiveSize {
+		return fmt.Errorf("archive too large: %d bytes > %d bytes", fileInfo.Size(), MaxArchiveSize)
+	}
+
+	return nil
+}
+
+// resolveFilePath resolves the file path relative to the base path.
+func (p *Provider) resolveFilePath(source string) string {
+	if filepath.IsAbs(source) {
+		return source
+	}
+	return filepath.Join(p.basePath, source)
+}
+
+// readZip reads the configuration entries of a zip archive.
+func readZip(ctx context.Context, filePath string) ([]entry, error) {
+	reader, err := zip.OpenReader(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	budget := &sizeBudget{remaining: MaxDecompressedSize}
+	var entries []entry
+	for _, file := range reader.File {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if file.FileInfo().IsDir() || !isConfigEntry(file.Name) {
+			continue
+		}
+
+		content, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+		}
+		data, err := budget.read(file.Name, content)
+		content.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if entries, err = appendEntry(entries, file.Name, data); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+// readTar reads the configuration entries of a tar archive, gunzipping it first when
+// its extension says so.
+func readTar(ctx context.Context, filePath string) ([]entry, error) {
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var stream io.Reader = file
+	if !strings.HasSuffix(strings.ToLower(filePath), ExtensionTar) {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip stream: %w", err)
+		}
+		defer gzipReader.Close()
+		stream = gzipReader
+	}
+
+	budget := &sizeBudget{remaining: MaxDecompressedSize}
+	reader := tar.NewReader(stream)
+	var entries []entry
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid tar stream: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg || !isConfigEntry(header.Name) {
+			continue
+		}
+
+		data, err := budget.read(header.Name, reader)
+		if err != nil {
+			return nil, err
+		}
+
+		if entries, err = appendEntry(entries, header.Name, data); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// appendEntry adds an entry, enforcing MaxEntries.
+func appendEntry(entries []entry, name string, data []byte) ([]entry, error) {
+	if len(entries) >= MaxEntries {
+		return nil, fmt.Errorf("too many configuration files: more than %d", MaxEntries)
+	}
+	return append(entries, entry{name: name, data: data}), nil
+}
+
+// sizeBudget tracks the decompressed bytes still allowed for one archive.
+type sizeBudget struct {
+	remaining int64
+}
+
+// read reads an entry, failing once it exceeds the per-file limit or the remaining budget.
+// Declared sizes are not trusted; the limit is enforced on the bytes actually read.
+func (b *sizeBudget) read(name string, reader io.Reader) ([]byte, error) {
+	limit := b.remaining
+	if limit > local.MaxFileSize {
+		limit = local.MaxFileSize
+	}
+
+	data, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	if int64(len(data)) > limit {
+		if limit == local.MaxFileSize {
+			return nil, fmt.Errorf("file %s too large: more than %d bytes", name, local.MaxFileSize)
+		}
+		return nil, fmt.Errorf("archive exceeds decompressed size limit of %d bytes", MaxDecompressedSize)
+	}
+
+	b.remaining -= int64(len(data))
+	return data, nil
+}
+
+// isConfigEntry reports whether an archive entry is a configuration file: a .env file
+// (".env", ".env.*" or "*.env") or a file with a JSON, YAML, .properties or CSV extension.
+func isConfigEntry(name string) bool {
+	if strings.HasPrefix(name, macOSMetadataDir) {
+		return false
+	}
+
+	base := path.Base(name)
+	if local.DetectFormat(base) != local.FormatEnv {
+		return true
+	}
+	return strings.HasPrefix(base, local.DefaultEnvFile) || strings.HasSuffix(base, local.DefaultEnvFile)
+}
+
+// isZip reports whether the path has a zip extension.
+func isZip(filePath string) bool {
+	return strings.HasSuffix(strings.ToLower(filePath), ExtensionZip)
+}
+
+// isTar reports whether the path has a tar or gzip-compressed tar extension.
+func isTar(filePath string) bool {
+	lower := strings.ToLower(filePath)
+	return strings.HasSuffix(lower, ExtensionTar) ||
+		strings.HasSuffix(lower, ExtensionTarGz) ||
+		strings.HasSuffix(lower, ExtensionTgz)
+}
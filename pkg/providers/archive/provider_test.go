@@ -0,0 +1,160 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// archiveFile is a file written into a test archive.
+type archiveFile struct {
+	name string
+	data []byte
+}
+
+// writeZip writes a zip archive of files into dir and returns its name.
+func writeZip(t *testing.T, dir string, files []archiveFile) string {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for _, file := range files {
+		content, err := writer.Create(file.name)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if _, err := content.Write(file.data); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	return writeArchive(t, dir, "bundle.zip", buffer.Bytes())
+}
+
+// writeTarGz writes a gzip-compressed tar archive of files into dir and returns its name.
+func writeTarGz(t *testing.T, dir string, files []archiveFile) string {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	writer := tar.NewWriter(gzipWriter)
+	for _, file := range files {
+		header := &tar.Header{Name: file.name, Mode: 0o600, Size: int64(len(file.data)), Typeflag: tar.TypeReg}
+		if err := writer.WriteHeader(header); err != nil {
+			t.Fatalf("WriteHeader() error = %v", err)
+		}
+		if _, err := writer.Write(file.data); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	return writeArchive(t, dir, "bundle.tar.gz", buffer.Bytes())
+}
+
+// writeArchive writes archive data into dir and returns its name.
+func writeArchive(t *testing.T, dir, name string, data []byte) string {
+	t.Helper()
+
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return name
+}
+
+// bundleFiles are configuration files merged in sorted entry order, with entries that are
+// not configuration files.
+var bundleFiles = []archiveFile{
+	{"config/b.json", []byte(`{"HOST": "db", "PORT": 5432}`)},
+	{"config/a.env", []byte("HOST=localhost\nUSER=app\n")},
+	{"README.md", []byte("HOST=ignored\n")},
+	{"__MACOSX/config/._a.env", []byte("HOST=ignored\n")},
+}
+
+func TestLoadArchives(t *testing.T) {
+	want := map[string]string{"HOST": "db", "PORT": "5432", "USER": "app"}
+
+	for _, write := range []func(*testing.T, string, []archiveFile) string{writeZip, writeTarGz} {
+		dir := t.TempDir()
+		name := write(t, dir, bundleFiles)
+		provider := NewProviderWithBase(dir)
+
+		if err := provider.Validate(name); err != nil {
+			t.Fatalf("Validate(%s) error = %v", name, err)
+		}
+		config, err := provider.Load(context.Background(), name)
+		if err != nil {
+			t.Fatalf("Load(%s) error = %v", name, err)
+		}
+		if !reflect.DeepEqual(config, want) {
+			t.Errorf("Load(%s) = %v, want %v", name, config, want)
+		}
+	}
+}
+
+func TestLoadRejectsOversizedEntries(t *testing.T) {
+	// Zeros compress to a few kilobytes that decompress past the per-file limit
+	bomb := []archiveFile{{"app.env", append([]byte("KEY="), make([]byte, local.MaxFileSize)...)}}
+
+	for _, write := range []func(*testing.T, string, []archiveFile) string{writeZip, writeTarGz} {
+		dir := t.TempDir()
+		name := write(t, dir, bomb)
+
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Stat() error = %v", err)
+		}
+		if info.Size() > local.MaxFileSize/100 {
+			t.Fatalf("%s is %d bytes, want a highly compressed archive", name, info.Size())
+		}
+
+		_, err = NewProviderWithBase(dir).Load(context.Background(), name)
+		if err == nil || !strings.Contains(err.Error(), "too large") {
+			t.Errorf("Load(%s) error = %v, want file too large", name, err)
+		}
+	}
+}
+
+func TestSizeBudget(t *testing.T) {
+	budget := &sizeBudget{remaining: 10}
+
+	if _, err := budget.read("a.env", strings.NewReader("A=1234")); err != nil {
+		t.Fatalf("read() within budget error = %v", err)
+	}
+	if _, err := budget.read("b.env", strings.NewReader("B=12345")); err == nil ||
+		!strings.Contains(err.Error(), "decompressed size limit") {
+		t.Errorf("read() over budget error = %v, want decompressed size limit", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	dir := t.TempDir()
+	provider := NewProviderWithBase(dir)
+
+	if err := provider.Validate("bundle.rar"); err == nil || !strings.Contains(err.Error(), "unsupported archive type") {
+		t.Errorf("Validate(bundle.rar) error = %v, want unsupported archive type", err)
+	}
+
+	var notFound *local.NotFoundError
+	if err := provider.Validate("missing.zip"); err == nil || !errors.As(err, &notFound) {
+		t.Errorf("Validate(missing.zip) error = %v, want *local.NotFoundError", err)
+	}
+}
@@ -0,0 +1,161 @@
+// Package dockersecrets provides a provider for Docker and Podman secrets mounted as files for go-envsync.
+// Every regular file in the secrets directory becomes one key, named after the file, whose value
+// is the file content with surrounding whitespace trimmed.
+package dockersecrets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// Constants for docker secrets provider
+const (
+	// ProviderName is the name of the docker secrets provider.
+	ProviderName = "docker-secrets"
+
+	// ProviderAlias is the alternative name of the docker secrets provider.
+	ProviderAlias = "secrets-dir"
+
+	// DefaultSecretsDir is the directory Docker and Podman mount secrets in.
+	DefaultSecretsDir = "/run/secrets"
+
+	// MaxSecretSize defines the maximum size in bytes of one secret file.
+	MaxSecretSize = 512 * 1024 // 512KB, the size limit of Docker secrets
+
+	// MaxSecrets defines the maximum number of secret files read from one directory.
+	MaxSecrets = 1000
+)
+
+// Provider implements a provider that loads secrets mounted as files in a directory.
+type Provider struct {
+	secretsDir string
+}
+
+// NewProvider creates a new docker secrets provider reading DefaultSecretsDir.
+func NewProvider() *Provider {
+	return NewProviderWithDir(DefaultSecretsDir)
+}
+
+// NewProviderWithDir creates a new docker secrets provider with the specified secrets directory.
+// It is used for empty sources, and relative sources are resolved against it.
+func NewProviderWithDir(secretsDir string) *Provider {
+	if secretsDir == "" {
+		secretsDir = DefaultSecretsDir
+	}
+
+	return &Provider{
+		secretsDir: secretsDir,
+	}
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// Load reads every regular file of the secrets directory, in sorted order, as one key.
+// Directories and other non-regular files are skipped; symbolic links are followed.
+func (p *Provider) Load(ctx context.Context, source string) (map[string]string, error) {
+	dirPath := p.resolveDirPath(source)
+
+	entries, err := os.ReadDir(dirPath)
+	if os.IsNotExist(err) {
+		return nil, &local.NotFoundError{Path: dirPath}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secrets directory %s: %w", dirPath, err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	config := make(map[string]string)
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Skip directories, sockets and other non-regular files
+		filePath := filepath.Join(dirPath, entry.Name())
+		fileInfo, err := os.Stat(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat secret %s: %w", filePath, err)
+		}
+		if !fileInfo.Mode().IsRegular() {
+			continue
+		}
+
+		if len(config) >= MaxSecrets {
+			return nil, fmt.Errorf("too many secrets in %s: more than %d", dirPath, MaxSecrets)
+		}
+
+		value, err := readSecret(filePath, fileInfo.Size())
+		if err != nil {
+			return nil, err
+		}
+		config[entry.Name()] = value
+	}
+
+	return config, nil
+}
+
+// Validate validates the source before loading.
+func (p *Provider) Validate(source string) error {
+	dirPath := p.resolveDirPath(source)
+
+	fileInfo, err := os.Stat(dirPath)
+	if os.IsNotExist(err) {
+		return &local.NotFoundError{Path: dirPath}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat secrets directory %s: %w", dirPath, err)
+	}
+
+	if !fileInfo.IsDir() {
+		return fmt.Errorf("source is not a directory: %s", dirPath)
+	}
+
+	return nil
+}
+
+// resolveDirPath resolves the secrets directory of a source: the provider's directory for an
+// empty source, and the source relative to it otherwise.
+func (p *Provider) resolveDirPath(source string) string {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return p.secretsDir
+	}
+	if filepath.IsAbs(source) {
+		return source
+	}
+	return filepath.Join(p.secretsDir, source)
+}
+
+// readSecret reads one secret file, enforcing MaxSecretSize on the bytes actually read.
+func readSecret(filePath string, size int64) (string, error) {
+	if size > MaxSecretSize {
+		return "", fmt.Errorf("secret %s too large: %d bytes > %d bytes", filePath, size, MaxSecretSize)
+	}
+
+	// #nosec G304 - filePath is a regular file listed in the configured secrets directory
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open secret %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxSecretSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s: %w", filePath, err)
+	}
+	if len(data) > MaxSecretSize {
+		return "", fmt.Errorf("secret %s too large: more than %d bytes", filePath, MaxSecretSize)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,120 @@
+package dockersecrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// writeSecrets writes every file of files to dir.
+func writeSecrets(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeSecrets(t, dir, map[string]string{
+		"db_password": "s3cr3t\n",
+		"api_token":   "  token with spaces \r\n",
+		"empty":       "",
+	})
+	if err := os.Mkdir(filepath.Join(dir, "nested"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	writeSecrets(t, filepath.Join(dir, "nested"), map[string]string{"inner": "skipped"})
+
+	want := map[string]string{
+		"db_password": "s3cr3t",
+		"api_token":   "token with spaces",
+		"empty":       "",
+	}
+
+	// Empty, relative and absolute sources all resolve to the directory
+	parent := filepath.Dir(dir)
+	tests := []struct {
+		name     string
+		provider *Provider
+		source   string
+	}{
+		{"empty source", NewProviderWithDir(dir), ""},
+		{"relative source", NewProviderWithDir(parent), filepath.Base(dir)},
+		{"absolute source", NewProvider(), dir},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.provider.Load(context.Background(), tt.source)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Load() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsOversizedSecrets(t *testing.T) {
+	dir := t.TempDir()
+	writeSecrets(t, dir, map[string]string{
+		"at_limit": strings.Repeat("a", MaxSecretSize),
+	})
+
+	config, err := NewProviderWithDir(dir).Load(context.Background(), "")
+	if err != nil || len(config["at_limit"]) != MaxSecretSize {
+		t.Fatalf("Load() of a secret at the limit = %d bytes, %v; want %d bytes", len(config["at_limit"]), err,
+			MaxSecretSize)
+	}
+
+	writeSecrets(t, dir, map[string]string{
+		"too_large": strings.Repeat("a", MaxSecretSize+1),
+	})
+	if _, err := NewProviderWithDir(dir).Load(context.Background(), ""); err == nil ||
+		!strings.Contains(err.Error(), "too large") {
+		t.Errorf("Load() error = %v, want secret too large", err)
+	}
+}
+
+func TestLoadHonorsContext(t *testing.T) {
+	dir := t.TempDir()
+	writeSecrets(t, dir, map[string]string{"token": "value"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := NewProviderWithDir(dir).Load(ctx, ""); !errors.Is(err, context.Canceled) {
+		t.Errorf("Load() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	dir := t.TempDir()
+	writeSecrets(t, dir, map[string]string{"token": "value"})
+	provider := NewProviderWithDir(dir)
+
+	if err := provider.Validate(""); err != nil {
+		t.Errorf("Validate() of the secrets directory error = %v", err)
+	}
+
+	var notFound *local.NotFoundError
+	if err := provider.Validate("missing"); !errors.As(err, &notFound) {
+		t.Errorf("Validate() of a missing directory error = %v, want NotFoundError", err)
+	}
+	if _, err := provider.Load(context.Background(), "missing"); !errors.As(err, &notFound) {
+		t.Errorf("Load() of a missing directory error = %v, want NotFoundError", err)
+	}
+
+	if err := provider.Validate("token"); err == nil || !strings.Contains(err.Error(), "not a directory") {
+		t.Errorf("Validate() of a file error = %v, want not a directory", err)
+	}
+}
@@ -3,11 +3,18 @@ package providers
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/providers/archive"
+	"github.com/Gosayram/go-envsync/pkg/providers/dockersecrets"
 	"github.com/Gosayram/go-envsync/pkg/providers/kubernetes"
 	"github.com/Gosayram/go-envsync/pkg/providers/local"
+	"github.com/Gosayram/go-envsync/pkg/providers/memory"
+	"github.com/Gosayram/go-envsync/pkg/providers/openbao"
+	"github.com/Gosayram/go-envsync/pkg/providers/plugin"
 	"github.com/Gosayram/go-envsync/pkg/providers/registry"
+	"github.com/Gosayram/go-envsync/pkg/providers/sops"
 	"github.com/Gosayram/go-envsync/pkg/providers/vault"
 )
 
@@ -19,8 +26,32 @@ const (
 	// KubernetesProviderDescription describes the Kubernetes provider.
 	KubernetesProviderDescription = "Load configuration from Kubernetes Secrets and ConfigMaps (requires k8s dependencies)"
 
+	// maxKubernetesQPS is the highest accepted Kubernetes qps and burst configuration.
+	maxKubernetesQPS = 1000
+
+	// maxKubernetesRetries is the highest accepted Kubernetes max_retries configuration.
+	maxKubernetesRetries = 10
+
 	// VaultProviderDescription describes the Vault provider.
 	VaultProviderDescription = "Load configuration from HashiCorp Vault secrets (requires Vault dependencies)"
+
+	// OpenBaoProviderDescription describes the OpenBao provider.
+	OpenBaoProviderDescription = "Load secrets from OpenBao, the Vault-compatible fork (requires Vault dependencies)"
+
+	// MemoryProviderDescription describes the in-memory provider.
+	MemoryProviderDescription = "Serve preset configuration from memory (testing and programmatic use)"
+
+	// SOPSProviderDescription describes the SOPS provider.
+	SOPSProviderDescription = "Load SOPS-encrypted .env, JSON and YAML files (requires the sops binary)"
+
+	// ArchiveProviderDescription describes the archive provider.
+	ArchiveProviderDescription = "Load configuration files bundled in zip and tar archives"
+
+	// DockerSecretsProviderDescription describes the docker secrets provider.
+	DockerSecretsProviderDescription = "Load Docker and Podman secrets mounted as files, such as /run/secrets/<name>"
+
+	// PluginProviderDescription describes the out-of-process plugin provider.
+	PluginProviderDescription = "Load configuration through an out-of-process plugin binary"
 )
 
 // InitializeProviders registers all available providers in the global registry.
@@ -40,6 +71,36 @@ func InitializeProviders() error {
 		return fmt.Errorf("failed to initialize vault provider: %w", err)
 	}
 
+	// Initialize OpenBao provider
+	if err := initializeOpenBaoProvider(); err != nil {
+		return fmt.Errorf("failed to initialize openbao provider: %w", err)
+	}
+
+	// Initialize memory provider
+	if err := initializeMemoryProvider(); err != nil {
+		return fmt.Errorf("failed to initialize memory provider: %w", err)
+	}
+
+	// Initialize SOPS provider
+	if err := initializeSOPSProvider(); err != nil {
+		return fmt.Errorf("failed to initialize sops provider: %w", err)
+	}
+
+	// Initialize archive provider
+	if err := initializeArchiveProvider(); err != nil {
+		return fmt.Errorf("failed to initialize archive provider: %w", err)
+	}
+
+	// Initialize docker secrets provider
+	if err := initializeDockerSecretsProvider(); err != nil {
+		return fmt.Errorf("failed to initialize docker secrets provider: %w", err)
+	}
+
+	// Initialize plugin provider
+	if err := initializePluginProvider(); err != nil {
+		return fmt.Errorf("failed to initialize plugin provider: %w", err)
+	}
+
 	return nil
 }
 
@@ -57,7 +118,15 @@ func initializeLocalProvider() error {
 					basePath = pathStr
 				}
 			}
-			return local.NewProviderWithBase(basePath), nil
+
+			var options local.Options
+			allow, _, err := registry.ConfigBool(config, "allow_world_writable")
+			if err != nil {
+				return nil, err
+			}
+			options.AllowWorldWritable = allow
+
+			return local.NewProviderWithOptions(basePath, options), nil
 		},
 		SupportedSources: []string{
 			".env",
@@ -65,7 +134,11 @@ func initializeLocalProvider() error {
 			"config.json",
 			"config.yaml",
 		},
-		OptionalConfig: []string{"base_path"},
+		OptionalConfig: []string{"base_path", "allow_world_writable"},
+		ConfigSchema: map[string]registry.ConfigField{
+			"base_path":            {Type: registry.ConfigTypeString},
+			"allow_world_writable": {Type: registry.ConfigTypeBool},
+		},
 	}
 
 	return registry.Register(localInfo)
@@ -93,19 +166,66 @@ func initializeKubernetesProvider() error {
 				}
 			}
 
-			return kubernetes.NewProviderWithConfig(kubeconfig, namespace)
+			provider, err := kubernetes.NewProviderWithConfig(kubeconfig, namespace)
+			if err != nil {
+				return nil, err
+			}
+
+			return provider, configureKubernetesLimits(provider, config)
 		},
 		SupportedSources: []string{
 			"namespace/secret/secret-name",
 			"namespace/configmap/config-name",
 			"default/secret/app-secrets",
 		},
-		OptionalConfig: []string{"kubeconfig", "context", "namespace"},
+		OptionalConfig: []string{"kubeconfig", "context", "namespace", "qps", "burst", "max_retries"},
+		ConfigSchema: map[string]registry.ConfigField{
+			"kubeconfig":  {Type: registry.ConfigTypeString},
+			"context":     {Type: registry.ConfigTypeString},
+			"namespace":   {Type: registry.ConfigTypeString},
+			"qps":         {Type: registry.ConfigTypeInt, Range: &registry.IntRange{Min: 1, Max: maxKubernetesQPS}},
+			"burst":       {Type: registry.ConfigTypeInt, Range: &registry.IntRange{Min: 1, Max: maxKubernetesQPS}},
+			"max_retries": {Type: registry.ConfigTypeInt, Range: &registry.IntRange{Min: 0, Max: maxKubernetesRetries}},
+		},
 	}
 
 	return registry.Register(k8sInfo)
 }
 
+// configureKubernetesLimits applies the optional rate limit and retry configuration of the
+// Kubernetes provider; unset values keep the provider defaults.
+func configureKubernetesLimits(provider *kubernetes.Provider, config map[string]interface{}) error {
+	qps, _, err := registry.ConfigInt(config, "qps")
+	if err != nil {
+		return err
+	}
+	if qps == 0 {
+		qps = kubernetes.DefaultQPS
+	}
+
+	burst, _, err := registry.ConfigInt(config, "burst")
+	if err != nil {
+		return err
+	}
+	if burst == 0 {
+		burst = kubernetes.DefaultBurst
+	}
+
+	if err := provider.SetRateLimit(float64(qps), burst); err != nil {
+		return err
+	}
+
+	maxRetries, exists, err := registry.ConfigInt(config, "max_retries")
+	if err != nil {
+		return err
+	}
+	if exists {
+		provider.SetMaxRetries(maxRetries)
+	}
+
+	return nil
+}
+
 // initializeVaultProvider registers the HashiCorp Vault provider.
 func initializeVaultProvider() error {
 	vaultInfo := &registry.ProviderInfo{
@@ -114,27 +234,20 @@ func initializeVaultProvider() error {
 		Aliases:     []string{"hcvault", "hashicorp-vault"},
 		Priority:    registry.DefaultProviderPriority,
 		Factory: func(config map[string]interface{}) (client.Provider, error) {
-			var addr, token, mountPath string
-
-			if a, exists := config["address"]; exists {
-				if aStr, ok := a.(string); ok {
-					addr = aStr
-				}
+			addr, token, mountPath, timeout, err := kvConfig(config)
+			if err != nil {
+				return nil, err
 			}
 
-			if t, exists := config["token"]; exists {
-				if tStr, ok := t.(string); ok {
-					token = tStr
-				}
+			provider, err := vault.NewProviderWithConfig(addr, token, mountPath)
+			if err != nil {
+				return nil, err
 			}
-
-			if mp, exists := config["mount_path"]; exists {
-				if mpStr, ok := mp.(string); ok {
-					mountPath = mpStr
-				}
+			if timeout > 0 {
+				provider.SetTimeout(timeout)
 			}
 
-			return vault.NewProviderWithConfig(addr, token, mountPath)
+			return provider, nil
 		},
 		SupportedSources: []string{
 			"secret/data/app-config",
@@ -142,12 +255,306 @@ func initializeVaultProvider() error {
 			"auth/token/secrets",
 		},
 		RequiredConfig: []string{"token"},
-		OptionalConfig: []string{"address", "mount_path", "version"},
+		OptionalConfig: []string{"address", "mount_path", "version", registry.TimeoutConfigKey},
+		ConfigSchema:   kvConfigSchema(vault.MaxKVVersion),
 	}
 
 	return registry.Register(vaultInfo)
 }
 
+// initializeOpenBaoProvider registers the OpenBao provider, a Vault-compatible fork.
+func initializeOpenBaoProvider() error {
+	openBaoInfo := &registry.ProviderInfo{
+		Name:        openbao.ProviderName,
+		Description: OpenBaoProviderDescription,
+		Aliases:     []string{openbao.ProviderAlias},
+		Priority:    registry.DefaultProviderPriority,
+		Factory: func(config map[string]interface{}) (client.Provider, error) {
+			addr, token, mountPath, timeout, err := kvConfig(config)
+			if err != nil {
+				return nil, err
+			}
+
+			provider, err := openbao.NewProviderWithConfig(addr, token, mountPath)
+			if err != nil {
+				return nil, err
+			}
+			if timeout > 0 {
+				provider.SetTimeout(timeout)
+			}
+
+			return provider, nil
+		},
+		SupportedSources: []string{
+			"secret/data/app-config",
+			"kv/production/database",
+		},
+		RequiredConfig: []string{"token"},
+		OptionalConfig: []string{"address", "mount_path", "version", registry.TimeoutConfigKey},
+		ConfigSchema:   kvConfigSchema(openbao.MaxKVVersion),
+	}
+
+	return registry.Register(openBaoInfo)
+}
+
+// kvConfig reads the configuration shared by the Vault-compatible providers.
+func kvConfig(config map[string]interface{}) (addr, token, mountPath string, timeout time.Duration, err error) {
+	if a, exists := config["address"]; exists {
+		if aStr, ok := a.(string); ok {
+			addr = aStr
+		}
+	}
+
+	if t, exists := config["token"]; exists {
+		if tStr, ok := t.(string); ok {
+			token = tStr
+		}
+	}
+
+	if mp, exists := config["mount_path"]; exists {
+		if mpStr, ok := mp.(string); ok {
+			mountPath = mpStr
+		}
+	}
+
+	timeout, err = registry.ConfigTimeout(config)
+	return addr, token, mountPath, timeout, err
+}
+
+// kvConfigSchema returns the configuration schema shared by the Vault-compatible providers.
+func kvConfigSchema(maxKVVersion int) map[string]registry.ConfigField {
+	return map[string]registry.ConfigField{
+		"address":    {Type: registry.ConfigTypeString, NotEmpty: true},
+		"token":      {Type: registry.ConfigTypeString, NotEmpty: true},
+		"mount_path": {Type: registry.ConfigTypeString},
+		"version": {
+			Type:  registry.ConfigTypeInt,
+			Range: &registry.IntRange{Min: 1, Max: maxKVVersion},
+		},
+		registry.TimeoutConfigKey: {Type: registry.ConfigTypeDuration},
+	}
+}
+
+// initializeMemoryProvider registers the in-memory provider.
+func initializeMemoryProvider() error {
+	memoryInfo := &registry.ProviderInfo{
+		Name:        memory.ProviderName,
+		Description: MemoryProviderDescription,
+		Aliases:     []string{memory.ProviderAlias},
+		Priority:    registry.LowPriority,
+		Factory: func(config map[string]interface{}) (client.Provider, error) {
+			data := make(map[string]map[string]string)
+
+			if raw, exists := config["data"]; exists {
+				switch typed := raw.(type) {
+				case map[string]map[string]string:
+					data = typed
+				case map[string]interface{}:
+					for source, values := range typed {
+						sourceData, ok := values.(map[string]string)
+						if !ok {
+							return nil, fmt.Errorf("invalid data for source %s: expected map[string]string", source)
+						}
+						data[source] = sourceData
+					}
+				default:
+					return nil, fmt.Errorf("invalid data: expected map of source to key-value pairs")
+				}
+			}
+
+			return memory.NewProvider(data), nil
+		},
+		SupportedSources: []string{
+			"source-name",
+		},
+		OptionalConfig: []string{"data"},
+	}
+
+	return registry.Register(memoryInfo)
+}
+
+// initializeSOPSProvider registers the SOPS provider.
+func initializeSOPSProvider() error {
+	sopsInfo := &registry.ProviderInfo{
+		Name:        sops.ProviderName,
+		Description: SOPSProviderDescription,
+		Priority:    registry.HighPriority,
+		Factory: func(config map[string]interface{}) (client.Provider, error) {
+			provider := sops.NewProvider()
+
+			if path, exists := config["base_path"]; exists {
+				if pathStr, ok := path.(string); ok {
+					provider = sops.NewProviderWithBase(pathStr)
+				}
+			}
+
+			if binary, exists := config["binary"]; exists {
+				if binaryStr, ok := binary.(string); ok {
+					provider.SetBinary(binaryStr)
+				}
+			}
+
+			return provider, nil
+		},
+		SupportedSources: []string{
+			"secrets.enc.yaml",
+			"secrets.enc.json",
+			".env.enc",
+		},
+		OptionalConfig: []string{"base_path", "binary"},
+		ConfigSchema: map[string]registry.ConfigField{
+			"base_path": {Type: registry.ConfigTypeString},
+			"binary":    {Type: registry.ConfigTypeString, NotEmpty: true},
+		},
+	}
+
+	return registry.Register(sopsInfo)
+}
+
+// initializeArchiveProvider registers the zip and tar archive provider.
+func initializeArchiveProvider() error {
+	archiveInfo := &registry.ProviderInfo{
+		Name:        archive.ProviderName,
+		Description: ArchiveProviderDescription,
+		Aliases:     []string{"zip", "tar"},
+		Priority:    registry.HighPriority,
+		Factory: func(config map[string]interface{}) (client.Provider, error) {
+			if path, exists := config["base_path"]; exists {
+				if pathStr, ok := path.(string); ok {
+					return archive.NewProviderWithBase(pathStr), nil
+				}
+			}
+
+			return archive.NewProvider(), nil
+		},
+		SupportedSources: []string{
+			"bundle.zip",
+			"bundle.tar.gz",
+			"config/bundle.tgz",
+		},
+		OptionalConfig: []string{"base_path"},
+		ConfigSchema: map[string]registry.ConfigField{
+			"base_path": {Type: registry.ConfigTypeString},
+		},
+	}
+
+	return registry.Register(archiveInfo)
+}
+
+// initializeDockerSecretsProvider registers the provider for secrets mounted as files.
+func initializeDockerSecretsProvider() error {
+	dockerSecretsInfo := &registry.ProviderInfo{
+		Name:        dockersecrets.ProviderName,
+		Description: DockerSecretsProviderDescription,
+		Aliases:     []string{dockersecrets.ProviderAlias},
+		Priority:    registry.HighPriority,
+		Factory: func(config map[string]interface{}) (client.Provider, error) {
+			if path, exists := config["path"]; exists {
+				if pathStr, ok := path.(string); ok {
+					return dockersecrets.NewProviderWithDir(pathStr), nil
+				}
+			}
+
+			return dockersecrets.NewProvider(), nil
+		},
+		SupportedSources: []string{
+			dockersecrets.DefaultSecretsDir,
+			"/var/run/secrets/app",
+		},
+		OptionalConfig: []string{"path"},
+		ConfigSchema: map[string]registry.ConfigField{
+			"path": {Type: registry.ConfigTypeString},
+		},
+	}
+
+	return registry.Register(dockerSecretsInfo)
+}
+
+// initializePluginProvider registers the generic plugin provider, which runs the binary given in config.
+func initializePluginProvider() error {
+	return registry.Register(&registry.ProviderInfo{
+		Name:        plugin.ProviderName,
+		Description: PluginProviderDescription,
+		Priority:    registry.DefaultProviderPriority,
+		Factory: func(config map[string]interface{}) (client.Provider, error) {
+			var name, binary string
+
+			if b, exists := config["binary"]; exists {
+				if bStr, ok := b.(string); ok {
+					binary = bStr
+				}
+			}
+
+			if n, exists := config["name"]; exists {
+				if nStr, ok := n.(string); ok {
+					name = nStr
+				}
+			}
+
+			args, err := pluginArgs(config)
+			if err != nil {
+				return nil, err
+			}
+
+			return plugin.NewProvider(name, binary, args...)
+		},
+		SupportedSources: []string{
+			"plugin-defined-source",
+		},
+		RequiredConfig: []string{"binary"},
+		OptionalConfig: []string{"name", "args"},
+		ConfigSchema: map[string]registry.ConfigField{
+			"binary": {Type: registry.ConfigTypeString, NotEmpty: true},
+			"name":   {Type: registry.ConfigTypeString},
+		},
+	})
+}
+
+// RegisterPlugin registers a plugin binary as a provider under its own name, so it can be
+// created by name without passing the binary in config.
+func RegisterPlugin(name, binary string, args ...string) error {
+	if _, err := plugin.NewProvider(name, binary, args...); err != nil {
+		return err
+	}
+
+	return registry.Register(&registry.ProviderInfo{
+		Name:        name,
+		Description: fmt.Sprintf("Plugin provider served by %s", binary),
+		Priority:    registry.DefaultProviderPriority,
+		Factory: func(_ map[string]interface{}) (client.Provider, error) {
+			return plugin.NewProvider(name, binary, args...)
+		},
+		SupportedSources: []string{
+			"plugin-defined-source",
+		},
+	})
+}
+
+// pluginArgs reads the optional plugin arguments from config.
+func pluginArgs(config map[string]interface{}) ([]string, error) {
+	raw, exists := config["args"]
+	if !exists {
+		return nil, nil
+	}
+
+	switch typed := raw.(type) {
+	case []string:
+		return typed, nil
+	case []interface{}:
+		args := make([]string, 0, len(typed))
+		for _, arg := range typed {
+			argStr, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid plugin argument %v: expected string", arg)
+			}
+			args = append(args, argStr)
+		}
+		return args, nil
+	default:
+		return nil, fmt.Errorf("invalid args: expected list of strings")
+	}
+}
+
 // GetAvailableProviders returns information about all available providers.
 func GetAvailableProviders() []*registry.ProviderInfo {
 	return registry.ListProviders()
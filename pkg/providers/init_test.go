@@ -0,0 +1,40 @@
+package providers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/registry"
+)
+
+func TestLocalProviderAllowWorldWritableConfig(t *testing.T) {
+	if err := InitializeProviders(); err != nil {
+		t.Fatalf("InitializeProviders() error = %v", err)
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.env")
+	if err := os.WriteFile(path, []byte("KEY=value\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := os.Chmod(path, 0o666); err != nil {
+		t.Fatalf("Chmod() error = %v", err)
+	}
+
+	// Values as parsed from key=value assignments on the command line
+	for _, allow := range []string{"true", "false"} {
+		provider, err := registry.CreateProvider("local", map[string]interface{}{
+			"base_path":            dir,
+			"allow_world_writable": allow,
+		})
+		if err != nil {
+			t.Fatalf("CreateProvider(allow_world_writable=%s) error = %v", allow, err)
+		}
+
+		err = provider.Validate("app.env")
+		if allowed := err == nil; allowed != (allow == "true") {
+			t.Errorf("Validate() with allow_world_writable=%s error = %v", allow, err)
+		}
+	}
+}
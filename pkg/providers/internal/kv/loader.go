@@ -0,0 +1,179 @@
+// Package kv implements the KV secrets engine loader shared by the Vault-compatible providers
+// of go-envsync. HashiCorp Vault and OpenBao expose the same API, so the vault and openbao
+// providers differ only in their Backend defaults.
+// This is currently a stub implementation that will be completed when Vault API
+// dependencies are added to the project.
+package kv
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/retry"
+)
+
+// Constants for the KV loader
+const (
+	// DefaultTimeout for KV operations.
+	DefaultTimeout = 30 * time.Second
+
+	// MaxSecretSize defines the maximum size of a secret.
+	MaxSecretSize = 1048576 // 1MB
+
+	// DefaultMountPath is the default mount path for the KV engine.
+	DefaultMountPath = "secret"
+
+	// MaxKVVersion is the highest supported version of the KV secrets engine.
+	MaxKVVersion = 2
+)
+
+// Backend describes a Vault-compatible server product.
+type Backend struct {
+	// Name is the provider name used in error messages.
+	Name string
+
+	// DefaultAddress is the server address used when none is configured.
+	DefaultAddress string
+
+	// DefaultMaxRetries is the number of retries of failed requests passed to the
+	// retry helper unless overridden with SetMaxRetries.
+	DefaultMaxRetries int
+}
+
+// Loader reads secrets from the KV engine of a Vault-compatible server.
+// This is currently a stub implementation.
+type Loader struct {
+	backend    Backend
+	address    string
+	mountPath  string
+	timeout    time.Duration
+	maxRetries int
+	enabled    bool
+}
+
+// NewLoader creates a loader for the backend. Empty address and mount path default to
+// the backend's DefaultAddress and DefaultMountPath.
+func NewLoader(backend Backend, address, mountPath string) *Loader {
+	if address == "" {
+		address = backend.DefaultAddress
+	}
+	if mountPath == "" {
+		mountPath = DefaultMountPath
+	}
+
+	return &Loader{
+		backend:    backend,
+		address:    address,
+		mountPath:  mountPath,
+		timeout:    DefaultTimeout,
+		maxRetries: backend.DefaultMaxRetries,
+		enabled:    false, // Disabled until Vault API dependencies are added
+	}
+}
+
+// Load loads secrets from the KV engine.
+// Transient failures (timeouts, 5xx) are retried with exponential backoff up to maxRetries.
+func (l *Loader) Load(ctx context.Context, source string) (map[string]string, error) {
+	// Validate source
+	if err := l.Validate(source); err != nil {
+		return nil, err
+	}
+
+	config := retry.DefaultConfig()
+	config.MaxRetries = l.maxRetries
+
+	return retry.LoadWithBackoff(ctx, l.readSecret, source, config)
+}
+
+// readSecret performs a single read of a secret.
+// This is a stub implementation - actual implementation requires Vault API dependencies.
+func (l *Loader) readSecret(_ /* ctx */ context.Context, source string) (map[string]string, error) {
+	// TODO: Implement actual KV client integration
+	// For now, return a permanent error indicating the provider is not implemented
+	return nil, retry.Permanent(fmt.Errorf("%s provider is not yet implemented (would load from: %s)",
+		l.backend.Name, source))
+}
+
+// Validate validates the source before loading.
+// Currently performs basic validation only.
+func (l *Loader) Validate(source string) error {
+	if !l.enabled {
+		return fmt.Errorf("%s provider is not yet implemented", l.backend.Name)
+	}
+
+	// Check if source is empty
+	if strings.TrimSpace(source) == "" {
+		return fmt.Errorf("source path cannot be empty")
+	}
+
+	// Check if path is valid
+	if strings.Contains(source, "..") {
+		return fmt.Errorf("invalid path (contains ..): %s", source)
+	}
+
+	return nil
+}
+
+// HealthCheck verifies that the loader can authenticate against the server.
+// This is a stub implementation - actual implementation requires Vault API dependencies.
+func (l *Loader) HealthCheck(_ /* ctx */ context.Context) error {
+	if !l.enabled {
+		return fmt.Errorf("%s provider is not yet implemented (would check %s)", l.backend.Name, l.address)
+	}
+
+	return nil
+}
+
+// TestConnection authenticates against the server with the configured token.
+// This is a stub implementation - actual implementation requires Vault API dependencies.
+func (l *Loader) TestConnection(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("%s provider is not yet implemented (would authenticate against %s)",
+		l.backend.Name, l.address)
+}
+
+// SetTimeout sets the timeout for KV operations.
+func (l *Loader) SetTimeout(timeout time.Duration) {
+	l.timeout = timeout
+}
+
+// Timeout returns the timeout for KV operations, applied to each Load call by the client.
+func (l *Loader) Timeout() time.Duration {
+	return l.timeout
+}
+
+// SetMaxRetries sets the maximum number of retries for failed requests.
+func (l *Loader) SetMaxRetries(maxRetries int) {
+	if maxRetries < 0 {
+		maxRetries = 0
+	}
+	l.maxRetries = maxRetries
+}
+
+// SetMountPath sets the mount path for the KV engine.
+func (l *Loader) SetMountPath(mountPath string) {
+	if mountPath == "" {
+		mountPath = DefaultMountPath
+	}
+	l.mountPath = mountPath
+}
+
+// GetMountPath returns the current mount path.
+func (l *Loader) GetMountPath() string {
+	return l.mountPath
+}
+
+// Address returns the server address.
+func (l *Loader) Address() string {
+	return l.address
+}
+
+// IsEnabled returns true if the loader is enabled and ready to use.
+func (l *Loader) IsEnabled() bool {
+	return l.enabled
+}
@@ -7,6 +7,10 @@ import (
 	"context"
 	"fmt"
 	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+	"github.com/Gosayram/go-envsync/pkg/providers/retry"
 )
 
 // Constants for Kubernetes provider
@@ -26,6 +30,10 @@ const (
 	// DefaultNamespace is the default Kubernetes namespace.
 	DefaultNamespace = "default"
 
+	// DefaultMaxRetries is the default number of retries of throttled (429) and other
+	// transient API requests.
+	DefaultMaxRetries = 3
+
 	// MaxResourceSize defines the maximum size of a Kubernetes resource.
 	MaxResourceSize = 1048576 // 1MB
 
@@ -34,20 +42,36 @@ const (
 
 	// NamespaceResourceNameParts defines the expected number of parts for namespace/resource/name parsing.
 	NamespaceResourceNameParts = 3
+
+	// NamespaceResourceNameKeyParts defines the expected number of parts for
+	// namespace/resource/name/key parsing.
+	NamespaceResourceNameKeyParts = 4
 )
 
+// resourceRef identifies a Kubernetes resource and, optionally, a single data key
+// whose value holds a whole configuration document.
+type resourceRef struct {
+	namespace    string
+	resourceType string
+	name         string
+	key          string
+}
+
 // Provider implements Kubernetes provider for loading configuration from Secrets and ConfigMaps.
+// API requests are rate limited client-side, DefaultQPS with bursts of DefaultBurst unless
+// changed with SetRateLimit, across all loads of the provider, and throttled (429) or other
+// transient failures are retried with backoff up to DefaultMaxRetries times.
 type Provider struct {
 	kubeconfig string
 	namespace  string
+	limiter    *rateLimiter
+	maxRetries int
 	// TODO: Add k8s client when dependencies are ready
 }
 
 // NewProvider creates a new Kubernetes provider with default configuration.
 func NewProvider() (*Provider, error) {
-	return &Provider{
-		namespace: DefaultNamespace,
-	}, nil
+	return NewProviderWithConfig("", DefaultNamespace)
 }
 
 // NewProviderWithConfig creates a new Kubernetes provider with custom configuration.
@@ -56,8 +80,15 @@ func NewProviderWithConfig(_ /* kubeconfig */, namespace string) (*Provider, err
 		namespace = DefaultNamespace
 	}
 
+	limiter, err := newRateLimiter(DefaultQPS, DefaultBurst)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Provider{
-		namespace: namespace,
+		namespace:  namespace,
+		limiter:    limiter,
+		maxRetries: DefaultMaxRetries,
 	}, nil
 }
 
@@ -67,34 +98,99 @@ func (p *Provider) Name() string {
 }
 
 // Load loads configuration from Kubernetes resources.
+// Each request waits for the rate limiter, and throttled or transient failures are retried
+// with exponential backoff; waiting stops when ctx is done.
+func (p *Provider) Load(ctx context.Context, source string) (map[string]string, error) {
+	// Validate source before spending any requests on it
+	if _, err := p.parseSource(source); err != nil {
+		return nil, err
+	}
+
+	config := retry.DefaultConfig()
+	config.MaxRetries = p.maxRetries
+
+	return retry.LoadWithBackoff(ctx, p.readResource, source, config)
+}
+
+// readResource performs a single rate-limited read of a resource.
 // This is a stub implementation - actual implementation requires k8s.io dependencies.
-func (p *Provider) Load(_ /* ctx */ context.Context, source string) (map[string]string, error) {
-	// Parse source to extract namespace, resource type, and resource name
-	namespace, resourceType, resourceName, err := p.parseSource(source)
+func (p *Provider) readResource(ctx context.Context, source string) (map[string]string, error) {
+	// Parse source to extract namespace, resource type, resource name and optional key
+	ref, err := p.parseSource(source)
+	if err != nil {
+		return nil, retry.Permanent(err)
+	}
+
+	if err := p.limiter.Wait(ctx); err != nil {
+		return nil, retry.Permanent(err)
+	}
+
+	data, err := p.fetchResource(ctx, ref)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: Implement actual Kubernetes client integration
-	// For now, return an error indicating the provider is not implemented
-	return nil, fmt.Errorf("kubernetes provider is not yet implemented (would load %s/%s/%s)",
-		namespace, resourceType, resourceName)
+	// Parse the named key's value as a document instead of returning every key
+	if ref.key != "" {
+		config, err := parseKeyDocument(data, ref.key)
+		if err != nil {
+			return nil, retry.Permanent(err)
+		}
+		return config, nil
+	}
+
+	return data, nil
+}
+
+// fetchResource reads the data of a ConfigMap or Secret.
+// This is a stub implementation - actual implementation requires k8s.io dependencies.
+func (p *Provider) fetchResource(_ /* ctx */ context.Context, ref resourceRef) (map[string]string, error) {
+	// TODO: Implement actual Kubernetes client integration. API errors with a status code
+	// should be returned as *retry.StatusError, so that 429 responses are retried. For now,
+	// return an error indicating the provider is not implemented
+	target := fmt.Sprintf("%s/%s/%s", ref.namespace, ref.resourceType, ref.name)
+	if ref.key != "" {
+		target += "/" + ref.key
+	}
+	return nil, retry.Permanent(fmt.Errorf("kubernetes provider is not yet implemented (would load %s)", target))
 }
 
 // Validate validates the source format for Kubernetes resources.
 func (p *Provider) Validate(source string) error {
-	_, _, _, err := p.parseSource(source)
+	_, err := p.parseSource(source)
 	return err
 }
 
-// parseSource parses a Kubernetes source string to extract namespace, resource type, and name.
+// parseKeyDocument parses the value stored under key in resource data as a configuration
+// document. The format is detected from the key name (app.env, config.json, settings.yaml)
+// and defaults to .env when the key has no recognized extension.
+func parseKeyDocument(data map[string]string, key string) (map[string]string, error) {
+	value, exists := data[key]
+	if !exists {
+		return nil, fmt.Errorf("key %s not found in resource data", key)
+	}
+
+	if len(value) > MaxResourceSize {
+		return nil, fmt.Errorf("value of key %s too large: %d bytes > %d bytes", key, len(value), MaxResourceSize)
+	}
+
+	config, err := local.ParseDocument([]byte(value), local.DetectFormat(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s as a configuration document: %w", key, err)
+	}
+
+	return config, nil
+}
+
+// parseSource parses a Kubernetes source string to extract namespace, resource type, name and key.
 // Supported formats:
 // - "resource-name" (uses default namespace and assumes secret)
 // - "resource-type/resource-name" (uses default namespace)
 // - "namespace/resource-type/resource-name" (full specification)
-func (p *Provider) parseSource(source string) (namespace, resourceType, resourceName string, err error) {
+// - "namespace/resource-type/resource-name/key" (the key's value is parsed as a document)
+func (p *Provider) parseSource(source string) (resourceRef, error) {
 	if strings.TrimSpace(source) == "" {
-		return "", "", "", fmt.Errorf("source cannot be empty")
+		return resourceRef{}, fmt.Errorf("source cannot be empty")
 	}
 
 	parts := strings.Split(source, "/")
@@ -102,19 +198,65 @@ func (p *Provider) parseSource(source string) (namespace, resourceType, resource
 	switch len(parts) {
 	case 1:
 		// Just resource name, assume secret in default namespace
-		return p.namespace, SecretType, parts[0], nil
+		return resourceRef{namespace: p.namespace, resourceType: SecretType, name: parts[0]}, nil
 
 	case NamespaceResourceParts:
 		// resource-type/resource-name, use default namespace
-		return p.namespace, parts[0], parts[1], nil
+		return resourceRef{namespace: p.namespace, resourceType: parts[0], name: parts[1]}, nil
 
 	case NamespaceResourceNameParts:
 		// namespace/resource-type/resource-name
-		return parts[0], parts[1], parts[2], nil
+		return resourceRef{namespace: parts[0], resourceType: parts[1], name: parts[2]}, nil
+
+	case NamespaceResourceNameKeyParts:
+		// namespace/resource-type/resource-name/key
+		if parts[3] == "" {
+			return resourceRef{}, fmt.Errorf("invalid source format: %s (key cannot be empty)", source)
+		}
+		return resourceRef{namespace: parts[0], resourceType: parts[1], name: parts[2], key: parts[3]}, nil
 
 	default:
-		return "", "", "", fmt.Errorf("invalid source format: %s (expected: [namespace/]resource-type/resource-name)", source)
+		return resourceRef{}, fmt.Errorf(
+			"invalid source format: %s (expected: [namespace/]resource-type/resource-name[/key])", source)
+	}
+}
+
+// HealthCheck verifies that the provider can authenticate against the cluster.
+// This is a stub implementation - actual implementation requires k8s.io dependencies.
+// Until then it reports client.ErrHealthCheckUnsupported, which doctor shows as skipped.
+func (p *Provider) HealthCheck(_ /* ctx */ context.Context) error {
+	return fmt.Errorf("kubernetes provider is not yet implemented (would check namespace %s): %w",
+		p.namespace, client.ErrHealthCheckUnsupported)
+}
+
+// TestConnection authenticates against the cluster and checks access to the default namespace.
+// This is a stub implementation - actual implementation requires k8s.io dependencies.
+func (p *Provider) TestConnection(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("kubernetes provider is not yet implemented (would authenticate and access namespace %s)",
+		p.namespace)
+}
+
+// SetRateLimit sets the sustained rate of API requests per second and the number of requests
+// allowed in a burst, shared by all loads of the provider.
+func (p *Provider) SetRateLimit(qps float64, burst int) error {
+	limiter, err := newRateLimiter(qps, burst)
+	if err != nil {
+		return fmt.Errorf("invalid kubernetes rate limit: %w", err)
+	}
+	p.limiter = limiter
+	return nil
+}
+
+// SetMaxRetries sets the maximum number of retries for throttled and transient API failures.
+func (p *Provider) SetMaxRetries(maxRetries int) {
+	if maxRetries < 0 {
+		maxRetries = 0
 	}
+	p.maxRetries = maxRetries
 }
 
 // SetNamespace sets the default namespace for the provider.
@@ -0,0 +1,34 @@
+package kubernetes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseKeyDocument(t *testing.T) {
+	data := map[string]string{
+		"app.env":       "HOST=db\nPORT=5432\n",
+		"config.json":   `{"HOST": "db", "PORT": 5432}`,
+		"settings.yaml": "HOST: db\nPORT: 5432\n",
+		"plain":         "HOST=db\nPORT=5432\n",
+		"broken.json":   `{"HOST":`,
+	}
+
+	for _, key := range []string{"app.env", "config.json", "settings.yaml", "plain"} {
+		config, err := parseKeyDocument(data, key)
+		if err != nil {
+			t.Errorf("parseKeyDocument(%q) error = %v", key, err)
+			continue
+		}
+		if config["HOST"] != "db" || config["PORT"] != "5432" || len(config) != 2 {
+			t.Errorf("parseKeyDocument(%q) = %v, want HOST=db PORT=5432", key, config)
+		}
+	}
+
+	if _, err := parseKeyDocument(data, "missing.env"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("parseKeyDocument() of a missing key error = %v, want not found", err)
+	}
+	if _, err := parseKeyDocument(data, "broken.json"); err == nil {
+		t.Error("parseKeyDocument() of invalid JSON succeeded, want error")
+	}
+}
@@ -0,0 +1,85 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Constants for API rate limiting
+const (
+	// DefaultQPS is the default sustained rate of Kubernetes API requests per second,
+	// matching the client-go default.
+	DefaultQPS = 5
+
+	// DefaultBurst is the default number of requests allowed above DefaultQPS in a burst,
+	// matching the client-go default.
+	DefaultBurst = 10
+)
+
+// rateLimiter is a token bucket shared by all loads of one provider, so that batch loads
+// of many resources stay within the API server's limits.
+type rateLimiter struct {
+	mu     sync.Mutex
+	qps    float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter creates a rate limiter starting with a full bucket of burst tokens.
+func newRateLimiter(qps float64, burst int) (*rateLimiter, error) {
+	if qps <= 0 {
+		return nil, fmt.Errorf("qps must be positive, got %v", qps)
+	}
+	if burst < 1 {
+		return nil, fmt.Errorf("burst must be at least 1, got %d", burst)
+	}
+
+	return &rateLimiter{
+		qps:    qps,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}, nil
+}
+
+// Wait blocks until a request may be made, or returns the context error when ctx is done first.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("waiting for kubernetes API rate limit: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token when one is available and returns zero, or returns how long to wait
+// until the next token.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Refill tokens for the time passed since the last request
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.qps
+	if l.tokens > float64(l.burst) {
+		l.tokens = float64(l.burst)
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.qps * float64(time.Second))
+}
@@ -0,0 +1,68 @@
+package local
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ReadComments reports the comment lines directly preceding each key of a .env source,
+// without their leading "#". A blank line ends a comment, so a file header separated from
+// the first key is not attached to it; directive comments are skipped. Other formats have
+// no comments.
+func (p *Provider) ReadComments(source string) (map[string]string, error) {
+	if IsStdinSource(source) {
+		if p.stdinFormat != FormatEnv {
+			return nil, nil
+		}
+
+		reader, err := p.stdinReader()
+		if err != nil {
+			return nil, err
+		}
+		return scanComments(reader, StdinSource)
+	}
+
+	filePath := p.resolveFilePath(source)
+	if DetectFormat(filePath) != FormatEnv {
+		return nil, nil
+	}
+
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	return scanComments(file, filePath)
+}
+
+// scanComments scans .env content for the comment block preceding each key.
+func scanComments(reader io.Reader, name string) (map[string]string, error) {
+	comments := make(map[string]string)
+	var block []string
+
+	err := scanEnvLines(reader, name, func(_ int, line string) {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			block = nil
+		case strings.HasPrefix(trimmed, "#"):
+			if !directiveRegex.MatchString(trimmed) {
+				block = append(block, strings.TrimPrefix(strings.TrimPrefix(trimmed, "#"), " "))
+			}
+		default:
+			if key, _, ok := splitEnvLine(line); ok && len(block) > 0 {
+				comments[key] = strings.Join(block, "\n")
+			}
+			block = nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
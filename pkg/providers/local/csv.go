@@ -0,0 +1,49 @@
+package local
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Constants for CSV parsing
+const (
+	// FormatCSV represents a two-column key,value CSV file.
+	FormatCSV = "csv"
+
+	// csvColumns is the number of columns in a configuration CSV row.
+	csvColumns = 2
+)
+
+// ParseCSV parses a two-column key,value CSV into a configuration map.
+// A leading "key,value" header row is skipped; quoted values may contain commas and newlines.
+func ParseCSV(data []byte) (map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = csvColumns
+
+	config := make(map[string]string)
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+
+		// Skip the header row
+		if row == 1 && strings.EqualFold(record[0], "key") && strings.EqualFold(record[1], "value") {
+			continue
+		}
+
+		if strings.TrimSpace(record[0]) == "" {
+			return nil, fmt.Errorf("invalid CSV: empty key on row %d", row)
+		}
+		config[record[0]] = record[1]
+	}
+
+	return config, nil
+}
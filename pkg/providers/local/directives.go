@@ -0,0 +1,71 @@
+package local
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+)
+
+// directiveRegex matches a comment line holding a directive, such as "# @required A, B".
+var directiveRegex = regexp.MustCompile(`^#\s*@([A-Za-z][A-Za-z0-9_-]*)(?:\s+(.*))?$`)
+
+// ReadDirectives reports the directives declared in comment lines of a .env source, such as
+// "# @required DATABASE_URL, API_KEY". Other formats declare no directives.
+func (p *Provider) ReadDirectives(source string) ([]client.Directive, error) {
+	if IsStdinSource(source) {
+		if p.stdinFormat != FormatEnv {
+			return nil, nil
+		}
+
+		reader, err := p.stdinReader()
+		if err != nil {
+			return nil, err
+		}
+		return scanDirectives(reader, StdinSource)
+	}
+
+	filePath := p.resolveFilePath(source)
+	if DetectFormat(filePath) != FormatEnv {
+		return nil, nil
+	}
+
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	return scanDirectives(file, filePath)
+}
+
+// scanDirectives scans .env content for directive comments. Arguments are separated by
+// commas or whitespace.
+func scanDirectives(reader io.Reader, name string) ([]client.Directive, error) {
+	var directives []client.Directive
+
+	err := scanEnvLines(reader, name, func(lineNumber int, line string) {
+		match := directiveRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			return
+		}
+
+		args := strings.FieldsFunc(match[2], func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		directives = append(directives, client.Directive{
+			Name: strings.ToLower(match[1]),
+			Args: args,
+			Line: lineNumber,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return directives, nil
+}
@@ -0,0 +1,141 @@
+package local
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+)
+
+// Constants for line scanning
+const (
+	// ExportPrefix is the optional shell export prefix allowed before .env keys.
+	ExportPrefix = "export "
+)
+
+// FindDuplicateKeys reports keys defined more than once in a .env source, with line numbers.
+// Other formats are not scanned and report no duplicates.
+func (p *Provider) FindDuplicateKeys(source string) ([]client.DuplicateKey, error) {
+	if IsStdinSource(source) {
+		if p.stdinFormat != FormatEnv {
+			return nil, nil
+		}
+
+		reader, err := p.stdinReader()
+		if err != nil {
+			return nil, err
+		}
+		return scanDuplicateKeys(reader, StdinSource)
+	}
+
+	filePath := p.resolveFilePath(source)
+	if DetectFormat(filePath) != FormatEnv {
+		return nil, nil
+	}
+
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	return scanDuplicateKeys(file, filePath)
+}
+
+// scanDuplicateKeys scans .env content for keys defined more than once.
+func scanDuplicateKeys(reader io.Reader, name string) ([]client.DuplicateKey, error) {
+	var duplicates []client.DuplicateKey
+	firstLines := make(map[string]int)
+
+	err := scanEnvLines(reader, name, func(lineNumber int, line string) {
+		key, _, ok := splitEnvLine(line)
+		if !ok {
+			return
+		}
+
+		if first, exists := firstLines[key]; exists {
+			duplicates = append(duplicates, client.DuplicateKey{Key: key, Line: lineNumber, FirstLine: first})
+		} else {
+			firstLines[key] = lineNumber
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return duplicates, nil
+}
+
+// scanEnvLines calls visit for each line of .env content that does not continue a quoted
+// multiline value, with its 1-based line number.
+func scanEnvLines(reader io.Reader, name string, visit func(lineNumber int, line string)) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, MaxLineLength), MaxFileSize)
+
+	lineNumber := 0
+	var openQuote byte
+
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, string(utf8BOM))
+		}
+
+		// Skip continuation lines of a quoted multiline value
+		if openQuote != 0 {
+			if closesQuote(line, openQuote) {
+				openQuote = 0
+			}
+			continue
+		}
+
+		visit(lineNumber, line)
+
+		// Track quoted values that continue on following lines
+		_, value, ok := splitEnvLine(line)
+		if ok && value != "" && (value[0] == '"' || value[0] == '\'') && !closesQuote(value[1:], value[0]) {
+			openQuote = value[0]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to scan file %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// splitEnvLine extracts the key and raw value of a .env assignment line.
+// Blank lines and comments return ok=false.
+func splitEnvLine(line string) (key, value string, ok bool) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return "", "", false
+	}
+
+	trimmed = strings.TrimPrefix(trimmed, ExportPrefix)
+
+	separator := strings.IndexAny(trimmed, "=:")
+	if separator <= 0 {
+		return "", "", false
+	}
+
+	key = strings.TrimSpace(trimmed[:separator])
+	value = strings.TrimSpace(trimmed[separator+1:])
+	return key, value, key != ""
+}
+
+// closesQuote reports whether the text contains an unescaped closing quote character.
+func closesQuote(text string, quote byte) bool {
+	for i := 0; i < len(text); i++ {
+		if text[i] == quote && (i == 0 || text[i-1] != '\\') {
+			return true
+		}
+	}
+	return false
+}
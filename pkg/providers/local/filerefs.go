@@ -0,0 +1,71 @@
+package local
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Constants for file references
+const (
+	// FileRefPrefix marks a value that is replaced with the contents of the referenced file.
+	FileRefPrefix = "@file:"
+)
+
+// resolveFileRefs replaces values of the form "@file:<path>" with the contents of the file.
+// Relative paths are resolved against the directory of the source file.
+func resolveFileRefs(config map[string]string, sourcePath string) error {
+	baseDir := filepath.Dir(sourcePath)
+
+	for key, value := range config {
+		if !strings.HasPrefix(value, FileRefPrefix) {
+			continue
+		}
+
+		refPath := strings.TrimSpace(strings.TrimPrefix(value, FileRefPrefix))
+		if refPath == "" {
+			return fmt.Errorf("empty file reference for key %s", key)
+		}
+
+		if !filepath.IsAbs(refPath) {
+			refPath = filepath.Join(baseDir, refPath)
+		}
+
+		content, err := readFileRef(refPath)
+		if err != nil {
+			return fmt.Errorf("failed to resolve file reference for key %s: %w", key, err)
+		}
+
+		config[key] = content
+	}
+
+	return nil
+}
+
+// readFileRef reads a referenced file, enforcing the same size limit as source files.
+func readFileRef(filePath string) (string, error) {
+	fileInfo, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return "", &NotFoundError{Path: filePath}
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to stat file %s: %w", filePath, err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return "", fmt.Errorf("referenced path is not a regular file: %s", filePath)
+	}
+
+	if fileInfo.Size() > MaxFileSize {
+		return "", fmt.Errorf("file too large: %d bytes > %d bytes", fileInfo.Size(), MaxFileSize)
+	}
+
+	// #nosec G304 - filePath is resolved from a reference in a configured source
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,207 @@
+package local
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Gosayram/go-envsync/pkg/encryption"
+)
+
+// Constants for file formats
+const (
+	// FormatEnv represents .env file format.
+	FormatEnv = "env"
+
+	// FormatJSON represents JSON file format.
+	FormatJSON = "json"
+
+	// FormatYAML represents YAML file format.
+	FormatYAML = "yaml"
+
+	// FlattenDelimiter is the default separator of nested keys when flattening JSON/YAML documents.
+	// It maps cleanly to environment variable names: {"database": {"host": ...}} becomes database__host.
+	FlattenDelimiter = "__"
+
+	// ExportEnvelopeKey is the key holding configuration in go-envsync JSON/YAML exports.
+	ExportEnvelopeKey = "config"
+
+	// ExportMetadataKey is the key holding metadata in go-envsync JSON/YAML exports.
+	ExportMetadataKey = "metadata"
+
+	// envelopeWithMetadataKeys is the number of top-level keys in an export with metadata.
+	envelopeWithMetadataKeys = 2
+
+	// EncryptedExtension is the optional extension of encrypted files, ignored when detecting the format.
+	EncryptedExtension = ".enc"
+
+	// floatBitSize is the bit size used when formatting float values.
+	floatBitSize = 64
+)
+
+// utf8BOM is the byte order mark some Windows editors write at the start of UTF-8 files.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// DetectFormat returns the file format for a path based on its extension.
+// Files without a recognized extension are treated as .env files. A trailing .enc
+// extension is ignored, so "config.json.enc" is detected as JSON.
+func DetectFormat(filePath string) string {
+	filePath = strings.TrimSuffix(filePath, EncryptedExtension)
+
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		return FormatJSON
+	case ".yaml", ".yml":
+		return FormatYAML
+	case ".properties":
+		return FormatProperties
+	case ".csv":
+		return FormatCSV
+	default:
+		return FormatEnv
+	}
+}
+
+// readFile reads and parses a configuration file according to its format.
+// Files produced by an encrypted export are decrypted with the provider's passphrase first.
+// .env files larger than StreamingThreshold are parsed incrementally.
+func (p *Provider) readFile(filePath string) (map[string]string, error) {
+	if DetectFormat(filePath) == FormatEnv {
+		if info, err := os.Stat(filePath); err == nil && info.Size() > StreamingThreshold {
+			return p.readEnvFileStreaming(filePath)
+		}
+	}
+
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.decodeContent(data, DetectFormat(filePath))
+}
+
+// decodeContent decrypts content if needed and parses it according to the format.
+// A leading UTF-8 byte order mark is stripped first.
+func (p *Provider) decodeContent(data []byte, format string) (map[string]string, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+
+	// Decrypt encrypted exports
+	if encryption.IsEncrypted(data) {
+		key, err := encryption.ResolvePassphrase(p.decryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("file is encrypted: %w", err)
+		}
+
+		if data, err = encryption.Decrypt(data, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return ParseDocumentWithDelimiter(data, format, p.flattenDelimiter)
+}
+
+// ParseDocument parses JSON, YAML, .env, .properties or CSV content into a flat configuration map.
+// Nested objects are flattened into keys joined with FlattenDelimiter and arrays into indexed keys.
+func ParseDocument(data []byte, format string) (map[string]string, error) {
+	return ParseDocumentWithDelimiter(data, format, FlattenDelimiter)
+}
+
+// ParseDocumentWithDelimiter parses a document like ParseDocument, joining nested keys with
+// the given delimiter. An empty delimiter uses FlattenDelimiter.
+func ParseDocumentWithDelimiter(data []byte, format, delimiter string) (map[string]string, error) {
+	if delimiter == "" {
+		delimiter = FlattenDelimiter
+	}
+
+	var document map[string]interface{}
+
+	switch format {
+	case FormatJSON:
+		if err := json.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("invalid JSON document: %w", err)
+		}
+	case FormatYAML:
+		if err := yaml.Unmarshal(data, &document); err != nil {
+			return nil, fmt.Errorf("invalid YAML document: %w", err)
+		}
+	case FormatEnv:
+		if err := checkEnvSyntax(bytes.NewReader(data), FormatEnv); err != nil {
+			return nil, err
+		}
+		return godotenv.UnmarshalBytes(data)
+	case FormatProperties:
+		return ParseProperties(data)
+	case FormatCSV:
+		return ParseCSV(data)
+	default:
+		return nil, fmt.Errorf("unsupported file format: %s", format)
+	}
+
+	config := make(map[string]string)
+	flatten("", unwrapExportEnvelope(document), delimiter, config)
+	return config, nil
+}
+
+// unwrapExportEnvelope returns the config object of a document produced by the go-envsync exporter.
+func unwrapExportEnvelope(document map[string]interface{}) map[string]interface{} {
+	inner, ok := document[ExportEnvelopeKey].(map[string]interface{})
+	if !ok {
+		return document
+	}
+
+	switch len(document) {
+	case 1:
+		return inner
+	case envelopeWithMetadataKeys:
+		if _, hasMetadata := document[ExportMetadataKey].(map[string]interface{}); hasMetadata {
+			return inner
+		}
+	}
+
+	return document
+}
+
+// flatten writes nested values into config using delimited keys.
+func flatten(prefix string, value interface{}, delimiter string, config map[string]string) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			flatten(joinKey(prefix, key, delimiter), typed[key], delimiter, config)
+		}
+	case []interface{}:
+		for i, item := range typed {
+			flatten(joinKey(prefix, strconv.Itoa(i), delimiter), item, delimiter, config)
+		}
+	case nil:
+		config[prefix] = ""
+	case string:
+		config[prefix] = typed
+	case float64:
+		config[prefix] = strconv.FormatFloat(typed, 'f', -1, floatBitSize)
+	default:
+		config[prefix] = fmt.Sprint(typed)
+	}
+}
+
+// joinKey joins a prefix and key with the flatten delimiter.
+func joinKey(prefix, key, delimiter string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + delimiter + key
+}
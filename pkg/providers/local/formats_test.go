@@ -0,0 +1,87 @@
+package local
+
+import (
+	"context"
+	"testing"
+)
+
+// windowsEnvFixture is a .env file as written by Windows editors: a UTF-8 byte order
+// mark and CRLF line endings.
+const windowsEnvFixture = "\xEF\xBB\xBFFIRST=one\r\n" +
+	"# comment\r\n" +
+	"\r\n" +
+	"QUOTED=\"two words\"\r\n" +
+	"SINGLE='three'\r\n" +
+	"export EXPORTED=four\r\n" +
+	"LAST=five"
+
+// windowsEnvWant is the configuration windowsEnvFixture must load as.
+var windowsEnvWant = map[string]string{
+	"FIRST":    "one",
+	"QUOTED":   "two words",
+	"SINGLE":   "three",
+	"EXPORTED": "four",
+	"LAST":     "five",
+}
+
+func TestBOMAndCRLF(t *testing.T) {
+	provider := NewProvider()
+	filePath := writeTestFile(t, ".env", []byte(windowsEnvFixture))
+
+	tests := []struct {
+		name string
+		load func() (map[string]string, error)
+	}{
+		{
+			name: "decodeContent",
+			load: func() (map[string]string, error) {
+				config, err := provider.decodeContent([]byte(windowsEnvFixture), FormatEnv)
+				if err != nil {
+					return nil, err
+				}
+				return provider.finishLoad(config, filePath)
+			},
+		},
+		{
+			name: "streaming",
+			load: func() (map[string]string, error) {
+				return provider.readEnvFileStreaming(filePath)
+			},
+		},
+		{
+			name: "Load",
+			load: func() (map[string]string, error) {
+				return provider.Load(context.Background(), filePath)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.load()
+			if err != nil {
+				t.Fatalf("load error = %v", err)
+			}
+			if len(got) != len(windowsEnvWant) {
+				t.Errorf("loaded %q, want %q", got, windowsEnvWant)
+			}
+			for key, want := range windowsEnvWant {
+				if got[key] != want {
+					t.Errorf("key %q = %q, want %q", key, got[key], want)
+				}
+			}
+		})
+	}
+}
+
+func TestBOMAndCRLFDuplicateScan(t *testing.T) {
+	filePath := writeTestFile(t, ".env", []byte(windowsEnvFixture+"\r\nFIRST=again\r\n"))
+
+	duplicates, err := NewProvider().FindDuplicateKeys(filePath)
+	if err != nil {
+		t.Fatalf("FindDuplicateKeys() error = %v", err)
+	}
+	if len(duplicates) != 1 || duplicates[0].Key != "FIRST" {
+		t.Errorf("FindDuplicateKeys() = %+v, want FIRST only", duplicates)
+	}
+}
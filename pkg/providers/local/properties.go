@@ -0,0 +1,174 @@
+package local
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Constants for properties parsing
+const (
+	// FormatProperties represents Java .properties file format.
+	FormatProperties = "properties"
+
+	// unicodeEscapeDigits is the number of hex digits in a \uXXXX escape.
+	unicodeEscapeDigits = 4
+
+	// hexBase is the base of \uXXXX escape digits.
+	hexBase = 16
+
+	// utf16BitSize is the bit size of a \uXXXX code unit.
+	utf16BitSize = 16
+)
+
+// ParseProperties parses Java .properties content into a configuration map.
+// It supports "#" and "!" comments, "=", ":" and whitespace separators,
+// backslash line continuations and the escapes written by java.util.Properties.
+func ParseProperties(data []byte) (map[string]string, error) {
+	config := make(map[string]string)
+
+	for lineNumber, line := range logicalPropertyLines(string(data)) {
+		key, value := splitPropertyLine(line)
+
+		parsedKey, err := unescapeProperty(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key on logical line %d: %w", lineNumber+1, err)
+		}
+
+		parsedValue, err := unescapeProperty(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for key %s: %w", parsedKey, err)
+		}
+
+		config[parsedKey] = parsedValue
+	}
+
+	return config, nil
+}
+
+// logicalPropertyLines joins continuation lines and drops blank lines and comments.
+// Leading whitespace of every natural line is ignored.
+func logicalPropertyLines(text string) []string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	var lines []string
+	var current strings.Builder
+	continuing := false
+
+	for _, natural := range strings.Split(text, "\n") {
+		natural = strings.TrimLeft(natural, " \t\f")
+
+		if !continuing && (natural == "" || natural[0] == '#' || natural[0] == '!') {
+			continue
+		}
+
+		// A line ending in an odd number of backslashes continues on the next line
+		continuing = trailingBackslashes(natural)%2 == 1
+		if continuing {
+			natural = natural[:len(natural)-1]
+		}
+
+		current.WriteString(natural)
+		if !continuing {
+			lines = append(lines, current.String())
+			current.Reset()
+		}
+	}
+
+	if current.Len() > 0 {
+		lines = append(lines, current.String())
+	}
+
+	return lines
+}
+
+// trailingBackslashes counts the backslashes at the end of a line.
+func trailingBackslashes(line string) int {
+	count := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		count++
+	}
+	return count
+}
+
+// splitPropertyLine splits a logical line into its raw key and value.
+// The key ends at the first unescaped "=", ":" or whitespace.
+func splitPropertyLine(line string) (key, value string) {
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.IndexByte("=: \t\f", line[i]) >= 0 {
+			end = i
+			break
+		}
+	}
+
+	key = line[:end]
+	rest := strings.TrimLeft(line[end:], " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+
+	return key, rest
+}
+
+// unescapeProperty resolves backslash escapes, including \uXXXX and surrogate pairs.
+func unescapeProperty(text string) (string, error) {
+	if !strings.Contains(text, "\\") {
+		return text, nil
+	}
+
+	var builder strings.Builder
+	var units []uint16
+
+	flush := func() {
+		if len(units) > 0 {
+			builder.WriteString(string(utf16.Decode(units)))
+			units = units[:0]
+		}
+	}
+
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i == len(text)-1 {
+			flush()
+			builder.WriteByte(text[i])
+			continue
+		}
+
+		i++
+		if text[i] == 'u' {
+			if i+1+unicodeEscapeDigits > len(text) {
+				return "", fmt.Errorf("truncated unicode escape")
+			}
+			unit, err := strconv.ParseUint(text[i+1:i+1+unicodeEscapeDigits], hexBase, utf16BitSize)
+			if err != nil {
+				return "", fmt.Errorf("invalid unicode escape \\u%s", text[i+1:i+1+unicodeEscapeDigits])
+			}
+			units = append(units, uint16(unit))
+			i += unicodeEscapeDigits
+			continue
+		}
+
+		flush()
+		switch text[i] {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		default:
+			builder.WriteByte(text[i])
+		}
+	}
+	flush()
+
+	return builder.String(), nil
+}
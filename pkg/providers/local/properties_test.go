@@ -0,0 +1,105 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+)
+
+func TestPropertiesRoundTrip(t *testing.T) {
+	config := map[string]string{
+		"url":             "jdbc:postgresql://db:5432/app?a=b",
+		"path":            `C:\Program Files\app`,
+		"comment.chars":   "#not a comment !nor this",
+		"key with spaces": "value",
+		"key:with=seps":   "v",
+		"#leading":        "!bang",
+		"leading.space":   "  padded",
+		"unicode":         "héllo wörld ✓ 😀",
+		"multiline":       "first\nsecond\r\nthird\ttabbed",
+		"empty":           "",
+	}
+
+	data := exportData(t, exporter.NewMultiFormatExporter(""), config, exporter.FormatProperties)
+
+	loaded, err := ParseProperties(data)
+	if err != nil {
+		t.Fatalf("ParseProperties() error = %v\n%s", err, data)
+	}
+	if len(loaded) != len(config) {
+		t.Errorf("loaded %d keys, want %d: %v", len(loaded), len(config), loaded)
+	}
+	for key, want := range config {
+		if got, exists := loaded[key]; !exists || got != want {
+			t.Errorf("key %q = %q (present %v), want %q", key, got, exists, want)
+		}
+	}
+}
+
+func TestParseProperties(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "separators",
+			input: "a=1\nb:2\nc 3\nd = 4\n",
+			want:  map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"},
+		},
+		{
+			name:  "comments and blank lines",
+			input: "# hash\n! bang\n\n   \nkey=value\n",
+			want:  map[string]string{"key": "value"},
+		},
+		{
+			name:  "continuation lines",
+			input: "fruits=apple, \\\n    banana, \\\n    cherry\nnext=1\n",
+			want:  map[string]string{"fruits": "apple, banana, cherry", "next": "1"},
+		},
+		{
+			name:  "escaped backslash is not a continuation",
+			input: "dir=C:\\\\\nnext=1\n",
+			want:  map[string]string{"dir": `C:\`, "next": "1"},
+		},
+		{
+			name:  "unicode escapes",
+			input: "greeting=caf\\u00e9 \\ud83d\\ude00\n",
+			want:  map[string]string{"greeting": "café 😀"},
+		},
+		{
+			name:  "crlf line endings",
+			input: "a=1\r\nb=2\r\n",
+			want:  map[string]string{"a": "1", "b": "2"},
+		},
+		{
+			name:    "malformed unicode escape",
+			input:   "bad=\\u12\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProperties([]byte(tt.input))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseProperties() = %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseProperties() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("ParseProperties() = %v, want %v", got, tt.want)
+			}
+			for key, want := range tt.want {
+				if got[key] != want {
+					t.Errorf("key %q = %q, want %q", key, got[key], want)
+				}
+			}
+		})
+	}
+}
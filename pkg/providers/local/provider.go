@@ -3,12 +3,16 @@ package local
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
-	"github.com/joho/godotenv"
+	"github.com/Gosayram/go-envsync/pkg/client"
 )
 
 // Constants for local provider
@@ -22,6 +26,10 @@ const (
 	// MaxLineLength defines the maximum length of a single line in the file.
 	MaxLineLength = 8192
 
+	// MaxMultilineValueLength defines the default maximum length of a quoted multiline value,
+	// such as a PEM-encoded key or certificate chain.
+	MaxMultilineValueLength = 64 * 1024 // 64KB
+
 	// DefaultEnvFile is the default environment file name.
 	DefaultEnvFile = ".env"
 
@@ -29,26 +37,73 @@ const (
 	WorldWritableMask = 0o002
 )
 
+// NotFoundError reports a missing source file. It matches fs.ErrNotExist so callers
+// can detect missing files with errors.Is.
+type NotFoundError struct {
+	// Path is the resolved file path.
+	Path string
+}
+
+// Error returns the error message.
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("file not found: %s", e.Path)
+}
+
+// Is reports whether the error matches fs.ErrNotExist.
+func (e *NotFoundError) Is(target error) bool {
+	return target == fs.ErrNotExist
+}
+
+// Options defines optional behavior for the local provider.
+type Options struct {
+	// AllowWorldWritable downgrades the rejection of world-writable files to a warning
+	// logged through the provider's logger. Use only where permissions cannot be fixed,
+	// such as CI containers.
+	AllowWorldWritable bool
+}
+
 // Provider implements the local file system provider.
 type Provider struct {
-	basePath string
+	basePath           string
+	options            Options
+	logger             client.Logger
+	maxMultilineLength int
+	decryptionKey      string
+	resolveFileRefs    bool
+	flattenDelimiter   string
+
+	stdin       io.Reader
+	stdinFormat string
+	stdinData   []byte
+	stdinErr    error
+	stdinRead   bool
+	stdinMutex  sync.Mutex
 }
 
 // NewProvider creates a new local provider with the current directory as base path.
 func NewProvider() *Provider {
-	return &Provider{
-		basePath: ".",
-	}
+	return NewProviderWithBase(".")
 }
 
 // NewProviderWithBase creates a new local provider with the specified base path.
 func NewProviderWithBase(basePath string) *Provider {
+	return NewProviderWithOptions(basePath, Options{})
+}
+
+// NewProviderWithOptions creates a new local provider with the specified base path and options.
+func NewProviderWithOptions(basePath string, options Options) *Provider {
 	if basePath == "" {
 		basePath = "."
 	}
 
 	return &Provider{
-		basePath: basePath,
+		basePath:           basePath,
+		options:            options,
+		logger:             client.NopLogger(),
+		maxMultilineLength: MaxMultilineValueLength,
+		flattenDelimiter:   FlattenDelimiter,
+		stdin:              os.Stdin,
+		stdinFormat:        FormatEnv,
 	}
 }
 
@@ -57,14 +112,44 @@ func (p *Provider) Name() string {
 	return ProviderName
 }
 
-// Load loads configuration from a local file.
-func (p *Provider) Load(_ context.Context, source string) (map[string]string, error) {
+// Load loads configuration from a local file, or from standard input for the "-" source.
+// File reads honor the context: when it is cancelled or its deadline passes, Load returns
+// ctx.Err() without waiting for a stuck read (such as on a hung network mount) to finish,
+// and whatever the read produces afterwards, including partial content, is discarded.
+// Contexts that can never be cancelled are read directly; other reads are handed to an idle
+// background reader, and a new reader is only started when none is idle.
+func (p *Provider) Load(ctx context.Context, source string) (map[string]string, error) {
+	if IsStdinSource(source) {
+		return p.loadStdin()
+	}
+
 	// Resolve file path
 	filePath := p.resolveFilePath(source)
 
+	// Read directly when there is nothing to wait for
+	if ctx.Done() == nil {
+		return p.loadFile(filePath)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Read in the background, so a stuck read can be abandoned
+	results := startRead(p, filePath)
+	select {
+	case result := <-results:
+		resultPool.Put(results)
+		return result.config, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// loadFile stats, reads and decodes a configuration file.
+func (p *Provider) loadFile(filePath string) (map[string]string, error) {
 	// Check if file exists
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("file not found: %s", filePath)
+		return nil, &NotFoundError{Path: filePath}
 	}
 
 	// Check file size
@@ -72,10 +157,43 @@ func (p *Provider) Load(_ context.Context, source string) (map[string]string, er
 		return nil, err
 	}
 
-	// Load environment variables
-	config, err := godotenv.Read(filePath)
+	// Load configuration according to file format
+	config, err := p.readFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file %s: %w", filePath, err)
+	}
+
+	return p.finishLoad(config, filePath)
+}
+
+// loadStdin loads configuration from standard input using the configured stdin format.
+func (p *Provider) loadStdin() (map[string]string, error) {
+	data, err := p.readStdin()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read environment file %s: %w", filePath, err)
+		return nil, err
+	}
+
+	config, err := p.decodeContent(data, p.stdinFormat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration from stdin: %w", err)
+	}
+
+	// File references on stdin resolve relative to the base path
+	return p.finishLoad(config, filepath.Join(p.basePath, StdinSource))
+}
+
+// finishLoad resolves file references and validates loaded configuration.
+func (p *Provider) finishLoad(config map[string]string, sourcePath string) (map[string]string, error) {
+	// Drop carriage returns left by CRLF line endings
+	for key, value := range config {
+		config[key] = strings.TrimRight(value, "\r")
+	}
+
+	// Substitute @file: references
+	if p.resolveFileRefs {
+		if err := resolveFileRefs(config, sourcePath); err != nil {
+			return nil, err
+		}
 	}
 
 	// Validate loaded configuration
@@ -93,13 +211,18 @@ func (p *Provider) Validate(source string) error {
 		return fmt.Errorf("source cannot be empty")
 	}
 
+	// Standard input is validated while reading
+	if IsStdinSource(source) {
+		return nil
+	}
+
 	// Resolve file path
 	filePath := p.resolveFilePath(source)
 
 	// Check if file exists
 	fileInfo, err := os.Stat(filePath)
 	if os.IsNotExist(err) {
-		return fmt.Errorf("file not found: %s", filePath)
+		return &NotFoundError{Path: filePath}
 	}
 	if err != nil {
 		return fmt.Errorf("failed to stat file %s: %w", filePath, err)
@@ -171,6 +294,10 @@ func (p *Provider) validateFilePermissions(filePath string) error {
 
 	mode := fileInfo.Mode()
 	if mode&WorldWritableMask != 0 { // World-writable
+		if p.options.AllowWorldWritable {
+			p.logger.Warnf("file is world-writable, which is insecure: %s", filePath)
+			return nil
+		}
 		return fmt.Errorf("file is world-writable, which is insecure: %s", filePath)
 	}
 
@@ -185,9 +312,13 @@ func (p *Provider) validateConfiguration(config map[string]string) error {
 			return fmt.Errorf("empty key is not allowed")
 		}
 
-		// Check value length
-		if len(value) > MaxLineLength {
-			return fmt.Errorf("value too long for key %s: %d > %d", key, len(value), MaxLineLength)
+		// Check value length (multiline values span several lines and have their own limit)
+		maxLength := MaxLineLength
+		if strings.Contains(value, "\n") {
+			maxLength = p.maxMultilineLength
+		}
+		if len(value) > maxLength {
+			return fmt.Errorf("value too long for key %s: %d > %d", key, len(value), maxLength)
 		}
 
 		// Check for potentially problematic characters in keys
@@ -199,6 +330,39 @@ func (p *Provider) validateConfiguration(config map[string]string) error {
 	return nil
 }
 
+// HealthCheck verifies that the base path exists and is a directory.
+func (p *Provider) HealthCheck(_ context.Context) error {
+	fileInfo, err := os.Stat(p.basePath)
+	if err != nil {
+		return fmt.Errorf("base path is not accessible: %w", err)
+	}
+
+	if !fileInfo.IsDir() {
+		return fmt.Errorf("base path is not a directory: %s", p.basePath)
+	}
+
+	return nil
+}
+
+// TestConnection verifies that the base path is a directory whose entries can be listed.
+func (p *Provider) TestConnection(ctx context.Context) error {
+	if err := p.HealthCheck(ctx); err != nil {
+		return err
+	}
+
+	dir, err := os.Open(p.basePath)
+	if err != nil {
+		return fmt.Errorf("base path is not readable: %w", err)
+	}
+	defer dir.Close()
+
+	if _, err := dir.Readdirnames(1); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("base path is not readable: %w", err)
+	}
+
+	return nil
+}
+
 // SetBasePath sets the base path for resolving relative file paths.
 func (p *Provider) SetBasePath(basePath string) {
 	if basePath == "" {
@@ -207,6 +371,44 @@ func (p *Provider) SetBasePath(basePath string) {
 	p.basePath = basePath
 }
 
+// SetMaxMultilineLength sets the maximum length of a quoted multiline value.
+// Non-positive values restore the default.
+func (p *Provider) SetMaxMultilineLength(maxLength int) {
+	if maxLength <= 0 {
+		maxLength = MaxMultilineValueLength
+	}
+	p.maxMultilineLength = maxLength
+}
+
+// SetDecryptionKey sets the passphrase used to decrypt encrypted files.
+// When empty, the passphrase is read from the ENVSYNC_ENCRYPTION_KEY environment variable.
+func (p *Provider) SetDecryptionKey(passphrase string) {
+	p.decryptionKey = passphrase
+}
+
+// SetResolveFileRefs enables replacing "@file:<path>" values with the contents of the
+// referenced file. Relative paths are resolved against the directory of the source file.
+func (p *Provider) SetResolveFileRefs(enabled bool) {
+	p.resolveFileRefs = enabled
+}
+
+// SetLogger sets the logger receiving provider warnings. A nil logger discards them.
+func (p *Provider) SetLogger(logger client.Logger) {
+	if logger == nil {
+		logger = client.NopLogger()
+	}
+	p.logger = logger
+}
+
+// SetFlattenDelimiter sets the separator used to join nested JSON/YAML keys.
+// An empty value restores FlattenDelimiter.
+func (p *Provider) SetFlattenDelimiter(delimiter string) {
+	if delimiter == "" {
+		delimiter = FlattenDelimiter
+	}
+	p.flattenDelimiter = delimiter
+}
+
 // GetBasePath returns the current base path.
 func (p *Provider) GetBasePath() string {
 	return p.basePath
@@ -0,0 +1,150 @@
+package local
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/exporter"
+)
+
+// rsaPrivateKeyPEM returns a freshly generated RSA private key in PEM form.
+func rsaPrivateKeyPEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() error = %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+}
+
+// writeTestFile writes content to name in a temporary directory and returns its path.
+func writeTestFile(t *testing.T, name string, content []byte) string {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(filePath, content, 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	return filePath
+}
+
+// exportData exports config in format through the exporter and returns the written file.
+func exportData(t *testing.T, e *exporter.MultiFormatExporter, config map[string]string, format string) []byte {
+	t.Helper()
+
+	filePath := filepath.Join(t.TempDir(), "export."+format)
+	if err := e.Export(context.Background(), config, format+":"+filePath); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("os.ReadFile() error = %v", err)
+	}
+	return data
+}
+
+func TestLoadPEMRoundTrip(t *testing.T) {
+	privateKey := rsaPrivateKeyPEM(t)
+	config := map[string]string{
+		"PRIVATE_KEY": privateKey,
+		"APP_NAME":    "demo",
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		file   string
+	}{
+		{name: "env", format: exporter.FormatEnv, file: "secrets.env"},
+		{name: "json", format: exporter.FormatJSON, file: "secrets.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := exportData(t, exporter.NewMultiFormatExporter(""), config, tt.format)
+			filePath := writeTestFile(t, tt.file, data)
+
+			loaded, err := NewProvider().Load(context.Background(), filePath)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if loaded["PRIVATE_KEY"] != privateKey {
+				t.Errorf("PRIVATE_KEY = %q, want the full PEM", loaded["PRIVATE_KEY"])
+			}
+			if block, _ := pem.Decode([]byte(loaded["PRIVATE_KEY"])); block == nil {
+				t.Error("PRIVATE_KEY does not decode as PEM")
+			}
+			if loaded["APP_NAME"] != "demo" {
+				t.Errorf("APP_NAME = %q, want demo", loaded["APP_NAME"])
+			}
+		})
+	}
+}
+
+func TestLoadQuotedMultilineValue(t *testing.T) {
+	privateKey := rsaPrivateKeyPEM(t)
+	content := "BEFORE=1\nPRIVATE_KEY=\"" + privateKey + "\"\nAFTER=2\n"
+	filePath := writeTestFile(t, ".env", []byte(content))
+
+	loaded, err := NewProvider().Load(context.Background(), filePath)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded["PRIVATE_KEY"] != privateKey {
+		t.Errorf("PRIVATE_KEY = %q, want the full PEM", loaded["PRIVATE_KEY"])
+	}
+	if loaded["BEFORE"] != "1" || loaded["AFTER"] != "2" {
+		t.Errorf("surrounding keys = %q, %q, want 1, 2", loaded["BEFORE"], loaded["AFTER"])
+	}
+
+	// A value longer than the multiline limit is rejected
+	provider := NewProvider()
+	provider.SetMaxMultilineLength(len(privateKey) / 2)
+	if _, err := provider.Load(context.Background(), filePath); err == nil {
+		t.Error("Load() with a lower multiline limit succeeded, want error")
+	} else if strings.Contains(err.Error(), "PRIVATE KEY-----\n") {
+		t.Error("Load() error contains the secret value")
+	}
+}
+
+func TestLoadHonorsContext(t *testing.T) {
+	path := writeTestFile(t, "app.env", []byte("KEY=value\n"))
+	provider := NewProviderWithBase(filepath.Dir(path))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Sequential loads reuse the idle background reader
+	if _, err := provider.Load(ctx, "app.env"); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	goroutines := runtime.NumGoroutine()
+	for i := 0; i < 10; i++ {
+		config, err := provider.Load(ctx, "app.env")
+		if err != nil || config["KEY"] != "value" {
+			t.Fatalf("Load() = %v, %v; want KEY=value", config, err)
+		}
+	}
+	if after := runtime.NumGoroutine(); after > goroutines {
+		t.Errorf("sequential loads grew goroutines from %d to %d", goroutines, after)
+	}
+
+	cancel()
+	if _, err := provider.Load(ctx, "app.env"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Load() with a cancelled context error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,73 @@
+package local
+
+import "sync"
+
+// Constants for background file reads
+const (
+	// maxIdleReaders is the number of background readers kept parked for later reads.
+	maxIdleReaders = 16
+)
+
+// readResult is the outcome of a background file read.
+type readResult struct {
+	config map[string]string
+	err    error
+}
+
+// readRequest asks a background reader to load a file. The results channel is buffered,
+// so a reader whose caller gave up can still deliver the result and move on.
+type readRequest struct {
+	provider *Provider
+	filePath string
+	results  chan readResult
+}
+
+// idleReaders holds the request channels of parked background readers.
+var idleReaders = make(chan chan readRequest, maxIdleReaders)
+
+// resultPool recycles the result channels of completed reads. Channels of abandoned reads
+// are never returned, since their reader may still write to them.
+var resultPool = sync.Pool{
+	New: func() interface{} {
+		return make(chan readResult, 1)
+	},
+}
+
+// startRead hands a file read to a parked background reader, starting a new reader only
+// when none is parked, such as while an earlier read is stuck, and returns the channel the
+// result is delivered on.
+func startRead(p *Provider, filePath string) chan readResult {
+	request := readRequest{provider: p, filePath: filePath, results: resultPool.Get().(chan readResult)}
+
+	select {
+	case requests := <-idleReaders:
+		requests <- request
+	default:
+		go runReader(request)
+	}
+
+	return request.results
+}
+
+// runReader serves read requests, starting with the given one. A reader parks itself
+// before delivering each result, so the caller's next read finds it, and exits instead
+// when maxIdleReaders are already parked.
+func runReader(request readRequest) {
+	requests := make(chan readRequest, 1)
+	for {
+		config, err := request.provider.loadFile(request.filePath)
+
+		parked := true
+		select {
+		case idleReaders <- requests:
+		default:
+			parked = false
+		}
+
+		request.results <- readResult{config: config, err: err}
+		if !parked {
+			return
+		}
+		request = <-requests
+	}
+}
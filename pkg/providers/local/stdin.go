@@ -0,0 +1,80 @@
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Constants for stdin sources
+const (
+	// StdinSource is the source name that reads configuration from standard input.
+	StdinSource = "-"
+)
+
+// IsStdinSource reports whether the source reads from standard input.
+func IsStdinSource(source string) bool {
+	return strings.TrimSpace(source) == StdinSource
+}
+
+// SetStdinFormat sets the format used to parse standard input (env, json, yaml, properties, csv).
+// Standard input has no extension, so the format defaults to env.
+func (p *Provider) SetStdinFormat(format string) error {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = FormatEnv
+	}
+
+	switch format {
+	case FormatEnv, FormatJSON, FormatYAML, FormatProperties, FormatCSV:
+		p.stdinFormat = format
+		return nil
+	default:
+		return fmt.Errorf("unsupported stdin format: %s", format)
+	}
+}
+
+// SetStdin sets the reader used for the "-" source. It defaults to os.Stdin.
+func (p *Provider) SetStdin(reader io.Reader) {
+	p.stdinMutex.Lock()
+	defer p.stdinMutex.Unlock()
+
+	p.stdin = reader
+	p.stdinData = nil
+	p.stdinErr = nil
+	p.stdinRead = false
+}
+
+// readStdin reads standard input once, enforcing MaxFileSize on the bytes read.
+// Later calls return the buffered content, so duplicate detection and loading see the same data.
+func (p *Provider) readStdin() ([]byte, error) {
+	p.stdinMutex.Lock()
+	defer p.stdinMutex.Unlock()
+
+	if p.stdinRead {
+		return p.stdinData, p.stdinErr
+	}
+	p.stdinRead = true
+
+	data, err := io.ReadAll(io.LimitReader(p.stdin, MaxFileSize+1))
+	switch {
+	case err != nil:
+		p.stdinErr = fmt.Errorf("failed to read stdin: %w", err)
+	case len(data) > MaxFileSize:
+		p.stdinErr = fmt.Errorf("stdin too large: more than %d bytes", MaxFileSize)
+	default:
+		p.stdinData = data
+	}
+
+	return p.stdinData, p.stdinErr
+}
+
+// stdinReader returns a reader over the buffered standard input.
+func (p *Provider) stdinReader() (io.Reader, error) {
+	data, err := p.readStdin()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
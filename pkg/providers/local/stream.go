@@ -0,0 +1,320 @@
+package local
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/Gosayram/go-envsync/pkg/encryption"
+)
+
+// Constants for streaming .env parsing
+const (
+	// StreamingThreshold is the file size in bytes above which .env files are parsed
+	// incrementally instead of being read into memory as a whole.
+	StreamingThreshold = 1024 * 1024 // 1MB
+
+	// streamReadSize is the size of the read buffer used by the streaming parser.
+	streamReadSize = 32 * 1024
+)
+
+// errUnterminatedQuote reports a quoted value without a closing quote.
+var errUnterminatedQuote = errors.New("unterminated quoted value")
+
+// Regular expressions mirroring the godotenv escape and expansion rules
+var (
+	envEscapeRegex        = regexp.MustCompile(`\\.`)
+	envExpandVarRegex     = regexp.MustCompile(`(\\)?(\$)(\()?\{?([A-Z0-9_]+)?\}?`)
+	envUnescapeCharsRegex = regexp.MustCompile(`\\([^$])`)
+)
+
+// readEnvFileStreaming parses a large .env file statement by statement, so that at most one
+// statement is buffered alongside the parsed map. Encrypted exports cannot be parsed
+// incrementally and are read whole.
+func (p *Provider) readEnvFileStreaming(filePath string) (map[string]string, error) {
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, streamReadSize)
+
+	// Fall back to whole-file decoding for encrypted exports
+	header, _ := reader.Peek(len(utf8BOM) + len(encryption.Header) + 1)
+	if encryption.IsEncrypted(bytes.TrimPrefix(header, utf8BOM)) {
+		data, readErr := io.ReadAll(reader)
+		if readErr != nil {
+			return nil, readErr
+		}
+		return p.decodeContent(data, FormatEnv)
+	}
+
+	if err := checkEnvFileSyntax(filePath); err != nil {
+		return nil, err
+	}
+	return parseEnvStream(reader, MaxLineLength+p.maxMultilineLength)
+}
+
+// envStream holds the unparsed remainder of a streamed .env document.
+type envStream struct {
+	reader       *bufio.Reader
+	buffer       []byte
+	maxStatement int
+	started      bool
+	eof          bool
+}
+
+// parseEnvStream parses .env content with the same quoting, escaping and expansion rules as
+// godotenv, failing when a single statement exceeds maxStatement bytes.
+func parseEnvStream(reader io.Reader, maxStatement int) (map[string]string, error) {
+	stream := &envStream{
+		reader:       bufio.NewReaderSize(reader, streamReadSize),
+		maxStatement: maxStatement,
+	}
+	config := make(map[string]string)
+
+	for {
+		// Skip blank lines and comments, reading more input when the buffer holds none
+		start := envStatementStart(stream.buffer)
+		if start == nil {
+			if stream.eof {
+				return config, nil
+			}
+			stream.buffer = stream.buffer[:0]
+			if err := stream.readLine(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		key, rest, err := envKeyName(start)
+		if err != nil {
+			return nil, err
+		}
+
+		value, left, err := envValue(rest, config)
+		if errors.Is(err, errUnterminatedQuote) && !stream.eof {
+			// Read the next line of a multiline quoted value and parse the statement again
+			stream.buffer = start
+			if readErr := stream.readLine(); readErr != nil {
+				return nil, readErr
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		config[key] = value
+		stream.buffer = left
+	}
+}
+
+// readLine appends the next input line to the buffer, normalizing CRLF line endings and
+// stripping a leading byte order mark.
+func (s *envStream) readLine() error {
+	var line []byte
+	for {
+		chunk, err := s.reader.ReadSlice('\n')
+		line = append(line, chunk...)
+		if len(s.buffer)+len(line) > s.maxStatement {
+			return fmt.Errorf("statement too long: more than %d bytes", s.maxStatement)
+		}
+
+		if errors.Is(err, bufio.ErrBufferFull) {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			s.eof = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+		break
+	}
+
+	if !s.started {
+		line = bytes.TrimPrefix(line, utf8BOM)
+		s.started = true
+	}
+	if bytes.HasSuffix(line, []byte("\r\n")) {
+		line = append(line[:len(line)-2], '\n')
+	}
+
+	s.buffer = append(s.buffer, line...)
+	return nil
+}
+
+// envStatementStart returns the input from the next statement on, skipping whitespace and
+// comment lines, or nil when none remains.
+func envStatementStart(src []byte) []byte {
+	for {
+		pos := bytes.IndexFunc(src, func(r rune) bool { return !unicode.IsSpace(r) })
+		if pos == -1 {
+			return nil
+		}
+
+		src = src[pos:]
+		if src[0] != '#' {
+			return src
+		}
+
+		// Skip comment line
+		pos = bytes.IndexByte(src, '\n')
+		if pos == -1 {
+			return nil
+		}
+		src = src[pos:]
+	}
+}
+
+// envKeyName parses the key of a statement, with an optional export prefix, and returns
+// the input following the = or : separator.
+func envKeyName(src []byte) (key string, rest []byte, err error) {
+	// Trim export prefix
+	src = bytes.TrimLeftFunc(src, isEnvSpace)
+	if trimmed, found := bytes.CutPrefix(src, []byte(strings.TrimSpace(ExportPrefix))); found {
+		if bytes.IndexFunc(trimmed, isEnvSpace) == 0 {
+			src = bytes.TrimLeftFunc(trimmed, isEnvSpace)
+		}
+	}
+
+	// Locate the separator, validating key characters on the way
+	offset := 0
+loop:
+	for i, char := range src {
+		r := rune(char)
+		if isEnvSpace(r) {
+			continue
+		}
+
+		switch char {
+		case '=', ':':
+			key = string(src[:i])
+			offset = i + 1
+			break loop
+		case '_':
+		default:
+			if unicode.IsLetter(r) || unicode.IsNumber(r) || r == '.' {
+				continue
+			}
+			return "", nil, fmt.Errorf("unexpected character %q in variable name near %q", string(char), string(src))
+		}
+	}
+
+	if len(src) == 0 {
+		return "", nil, errors.New("zero length string")
+	}
+
+	key = strings.TrimRightFunc(key, unicode.IsSpace)
+	return key, bytes.TrimLeftFunc(src[offset:], isEnvSpace), nil
+}
+
+// envValue parses the value of a statement and returns the input following it.
+// Earlier keys in config are used to expand variable references.
+func envValue(src []byte, config map[string]string) (value string, rest []byte, err error) {
+	if len(src) == 0 || (src[0] != '"' && src[0] != '\'') {
+		return envUnquotedValue(src, config)
+	}
+
+	// Look for the closing quote, skipping escaped quotes
+	quote := src[0]
+	for i := 1; i < len(src); i++ {
+		if src[i] != quote || src[i-1] == '\\' {
+			continue
+		}
+
+		isQuote := func(r rune) bool { return r == rune(quote) }
+		value = string(bytes.TrimLeftFunc(bytes.TrimRightFunc(src[:i], isQuote), isQuote))
+		if quote == '"' {
+			value = expandEnvVariables(expandEnvEscapes(value), config)
+		}
+		return value, src[i+1:], nil
+	}
+
+	end := bytes.IndexByte(src, '\n')
+	if end == -1 {
+		end = len(src)
+	}
+	return "", nil, fmt.Errorf("%w %s", errUnterminatedQuote, src[:end])
+}
+
+// envUnquotedValue parses an unquoted value up to the end of the line, dropping an inline
+// comment preceded by whitespace.
+func envUnquotedValue(src []byte, config map[string]string) (value string, rest []byte, err error) {
+	endOfLine := bytes.IndexFunc(src, func(r rune) bool { return r == '\n' || r == '\r' })
+	if endOfLine == -1 {
+		endOfLine = len(src)
+		if endOfLine == 0 {
+			return "", nil, nil
+		}
+	}
+
+	line := []rune(string(src[:endOfLine]))
+	endOfVar := len(line)
+	if endOfVar == 0 {
+		return "", src[endOfLine:], nil
+	}
+
+	for i := endOfVar - 1; i >= 0; i-- {
+		if line[i] == '#' && i > 0 && isEnvSpace(line[i-1]) {
+			endOfVar = i
+			break
+		}
+	}
+
+	trimmed := strings.TrimFunc(string(line[:endOfVar]), isEnvSpace)
+	return expandEnvVariables(trimmed, config), src[endOfLine:], nil
+}
+
+// expandEnvEscapes unescapes \n and \r and drops backslashes before other characters except $.
+func expandEnvEscapes(text string) string {
+	out := envEscapeRegex.ReplaceAllStringFunc(text, func(match string) string {
+		switch strings.TrimPrefix(match, `\`) {
+		case "n":
+			return "\n"
+		case "r":
+			return "\r"
+		default:
+			return match
+		}
+	})
+	return envUnescapeCharsRegex.ReplaceAllString(out, "$1")
+}
+
+// expandEnvVariables expands $NAME and ${NAME} references to keys parsed earlier.
+func expandEnvVariables(text string, config map[string]string) string {
+	return envExpandVarRegex.ReplaceAllStringFunc(text, func(match string) string {
+		submatch := envExpandVarRegex.FindStringSubmatch(match)
+		if submatch == nil {
+			return match
+		}
+
+		switch {
+		case submatch[1] == `\` || submatch[2] == "(":
+			return submatch[0][1:]
+		case submatch[4] != "":
+			return config[submatch[4]]
+		default:
+			return match
+		}
+	})
+}
+
+// isEnvSpace reports whether the rune is whitespace other than a line break.
+func isEnvSpace(r rune) bool {
+	switch r {
+	case '\t', '\v', '\f', '\r', ' ', 0x85, 0xA0:
+		return true
+	}
+	return false
+}
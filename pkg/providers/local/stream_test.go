@@ -0,0 +1,64 @@
+package local
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/joho/godotenv"
+)
+
+func TestParseEnvStreamMatchesGodotenv(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"plain", "A=1\nB=two words\n"},
+		{"no trailing newline", "A=1\nB=2"},
+		{"export prefix", "export A=1\nexport   B=2\n"},
+		{"colon separator", "A: 1\nB:2\n"},
+		{"comments", "# header\nA=1 # trailing\n  # indented\nB=2#not a comment\n"},
+		{"hash in quotes", "A=\"1 # kept\"\nB='2 # kept'\n"},
+		{"single quotes", "A='raw $B \\n value'\n"},
+		{"double quote escapes", "A=\"line\\nbreak\\ttab \\\"quoted\\\" back\\\\slash\"\n"},
+		{"escaped dollar", "A=1\nB=\"\\$A\"\n"},
+		{"expansion", "A=1\nB=${A}2\nC=$A$B\nD=\"${A}-$B\"\nE='${A}'\n"},
+		{"undefined expansion", "A=${MISSING}x\n"},
+		{"multiline double quotes", "A=\"first\nsecond\nthird\"\nB=2\n"},
+		{"multiline single quotes", "A='first\nsecond'\nB=2\n"},
+		{"crlf", "A=1\r\nB=\"2\"\r\n"},
+		{"whitespace", "  A = 1  \n\tB=\t2\t\n\n\n"},
+		{"empty values", "A=\nB=\"\"\nC=''\n"},
+		{"long value", "A=" + strings.Repeat("x", 3*streamReadSize) + "\nB=2\n"},
+		{"long multiline value", "A=\"" + strings.Repeat("y", 2*streamReadSize) + "\n" +
+			strings.Repeat("z", streamReadSize) + "\"\nB=2\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := godotenv.Unmarshal(tt.input)
+			if err != nil {
+				t.Fatalf("godotenv.Unmarshal() error = %v", err)
+			}
+
+			got, err := parseEnvStream(strings.NewReader(tt.input), len(tt.input)+1)
+			if err != nil {
+				t.Fatalf("parseEnvStream() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("parseEnvStream() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParseEnvStreamLimits(t *testing.T) {
+	if _, err := parseEnvStream(strings.NewReader("A=\"unterminated\nB=2\n"), MaxLineLength); err == nil {
+		t.Error("parseEnvStream() of an unterminated quote succeeded, want error")
+	}
+
+	long := "A=" + strings.Repeat("x", 100) + "\n"
+	if _, err := parseEnvStream(strings.NewReader(long), 50); err == nil {
+		t.Error("parseEnvStream() of a statement over the limit succeeded, want error")
+	}
+}
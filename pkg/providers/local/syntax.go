@@ -0,0 +1,55 @@
+package local
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// checkEnvSyntax reports the first malformed line of .env content with its line number:
+// a line that is not blank, a comment or a KEY=value assignment, an assignment without a
+// key, or a key containing whitespace. Whitespace around keys and values is allowed and
+// trimmed, so "KEY =v", " KEY=v" and "KEY= v" all set KEY to "v".
+func checkEnvSyntax(reader io.Reader, name string) error {
+	var syntaxErr error
+
+	err := scanEnvLines(reader, name, func(lineNumber int, line string) {
+		if syntaxErr != nil {
+			return
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			return
+		}
+
+		key, _, ok := splitEnvLine(line)
+		switch {
+		case !ok && strings.IndexAny(strings.TrimPrefix(trimmed, ExportPrefix), "=:") == 0:
+			syntaxErr = fmt.Errorf("line %d: missing key before '='", lineNumber)
+		case !ok:
+			// The line is not quoted, since it may hold a secret value
+			syntaxErr = fmt.Errorf("line %d: malformed line, expected KEY=value", lineNumber)
+		case strings.ContainsAny(key, " \t"):
+			syntaxErr = fmt.Errorf("line %d: key %q contains whitespace", lineNumber, key)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	return syntaxErr
+}
+
+// checkEnvFileSyntax checks the syntax of a .env file.
+func checkEnvFileSyntax(filePath string) error {
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return checkEnvSyntax(file, filePath)
+}
@@ -0,0 +1,95 @@
+package local
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestEnvWhitespaceTrimming(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "space before separator", input: "KEY =v\n"},
+		{name: "leading space", input: " KEY=v\n"},
+		{name: "space after separator", input: "KEY= v\n"},
+		{name: "spaces everywhere", input: "\t KEY \t=  v  \n"},
+		{name: "export prefix", input: "export KEY =v\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkEnvSyntax(strings.NewReader(tt.input), ".env"); err != nil {
+				t.Fatalf("checkEnvSyntax() error = %v", err)
+			}
+
+			filePath := writeTestFile(t, ".env", []byte(tt.input))
+			got, err := NewProvider().Load(context.Background(), filePath)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+			if len(got) != 1 || got["KEY"] != "v" {
+				t.Errorf("Load() = %q, want KEY=v only", got)
+			}
+		})
+	}
+}
+
+func TestCheckEnvSyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "missing key",
+			input:   "A=1\n=value\n",
+			wantErr: "line 2: missing key before '='",
+		},
+		{
+			name:    "missing key after export",
+			input:   "# header\n\nexport =value\n",
+			wantErr: "line 3: missing key before '='",
+		},
+		{
+			name:    "no separator",
+			input:   "A=1\nB=2\njust some text\n",
+			wantErr: "line 3: malformed line, expected KEY=value",
+		},
+		{
+			name:    "key with inner space",
+			input:   "MY KEY=v\n",
+			wantErr: `line 1: key "MY KEY" contains whitespace`,
+		},
+		{
+			name:    "line after multiline value",
+			input:   "PEM=\"first\nsecond\"\nbroken line\n",
+			wantErr: "line 3: malformed line",
+		},
+		{
+			name:    "first error wins",
+			input:   "bad one\nbad two\n",
+			wantErr: "line 1:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkEnvSyntax(strings.NewReader(tt.input), ".env")
+			if err == nil {
+				t.Fatalf("checkEnvSyntax() = nil, want error %q", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("checkEnvSyntax() error = %q, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckEnvSyntaxAccepts(t *testing.T) {
+	input := "# comment\n\n  # indented comment\nA=1\nexport B=2\nC:3\nD=\"multi\nline value\"\nE=\n"
+	if err := checkEnvSyntax(strings.NewReader(input), ".env"); err != nil {
+		t.Errorf("checkEnvSyntax() error = %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package local
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/dotenv"
+)
+
+// Constants for .env writing
+const (
+	// NewFilePermissions defines the permissions of .env files created by SetValues.
+	NewFilePermissions = 0o600
+)
+
+// SetValues adds or updates keys in a .env source while preserving all other lines,
+// comments and ordering. Existing definitions of a key are replaced in place (including
+// every line of a quoted multiline value); new keys are appended in argument order.
+// Values are quoted with the exporter's .env escaping rules. When create is false,
+// a missing file is an error.
+func (p *Provider) SetValues(source string, keys []string, values map[string]string, create bool) error {
+	filePath := p.resolveFilePath(source)
+	if DetectFormat(filePath) != FormatEnv {
+		return fmt.Errorf("only .env files can be updated: %s", filePath)
+	}
+
+	// Validate keys
+	for _, key := range keys {
+		if strings.TrimSpace(key) == "" || strings.ContainsAny(key, " \t\n\r=#") {
+			return fmt.Errorf("invalid key: %q", key)
+		}
+	}
+
+	// Read existing content
+	mode := os.FileMode(NewFilePermissions)
+	var lines []string
+
+	// #nosec G304 - filePath is resolved from configured sources
+	data, err := os.ReadFile(filePath)
+	switch {
+	case os.IsNotExist(err):
+		if !create {
+			return &NotFoundError{Path: filePath}
+		}
+	case err != nil:
+		return fmt.Errorf("failed to read file %s: %w", filePath, err)
+	default:
+		if info, statErr := os.Stat(filePath); statErr == nil {
+			mode = info.Mode().Perm()
+		}
+		if len(data) > 0 {
+			lines = strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		}
+	}
+
+	// Replace existing definitions, then append new keys
+	lines, found := replaceEnvLines(lines, values)
+	for _, key := range keys {
+		if !found[key] {
+			lines = append(lines, formatEnvLine(key, values[key], false))
+			found[key] = true
+		}
+	}
+
+	return writeFileAtomic(filePath, []byte(strings.Join(lines, "\n")+"\n"), mode)
+}
+
+// replaceEnvLines rewrites assignment lines for keys present in values and reports
+// which keys were found.
+func replaceEnvLines(lines []string, values map[string]string) ([]string, map[string]bool) {
+	found := make(map[string]bool, len(values))
+	result := make([]string, 0, len(lines))
+	var openQuote byte
+	skipping := false
+
+	for _, line := range lines {
+		// Handle continuation lines of a quoted multiline value
+		if openQuote != 0 {
+			if closesQuote(line, openQuote) {
+				openQuote = 0
+			}
+			if !skipping {
+				result = append(result, line)
+			}
+			continue
+		}
+		skipping = false
+
+		key, value, ok := splitEnvLine(line)
+		if ok && value != "" && (value[0] == '"' || value[0] == '\'') && !closesQuote(value[1:], value[0]) {
+			openQuote = value[0]
+		}
+
+		newValue, replace := values[key]
+		if !ok || !replace {
+			result = append(result, line)
+			continue
+		}
+
+		exported := strings.HasPrefix(strings.TrimSpace(line), ExportPrefix)
+		result = append(result, formatEnvLine(key, newValue, exported))
+		found[key] = true
+		skipping = openQuote != 0
+	}
+
+	return result, found
+}
+
+// formatEnvLine formats a single .env assignment.
+func formatEnvLine(key, value string, exported bool) string {
+	line := key + "=" + dotenv.EscapeValue(value)
+	if exported {
+		line = ExportPrefix + line
+	}
+	return line
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and renames it into place.
+func writeFileAtomic(filePath string, data []byte, mode os.FileMode) error {
+	dir := filepath.Dir(filePath)
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(filePath)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file in %s: %w", dir, err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := tmp.Chmod(mode); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set file permissions: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		return fmt.Errorf("failed to replace file %s: %w", filePath, err)
+	}
+
+	return nil
+}
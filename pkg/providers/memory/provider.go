@@ -0,0 +1,106 @@
+// Package memory provides an in-memory provider for go-envsync.
+// It is intended for testing and for programmatic configuration injection.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Constants for memory provider
+const (
+	// ProviderName is the name of the memory provider.
+	ProviderName = "memory"
+
+	// ProviderAlias is the short alias for the provider.
+	ProviderAlias = "mem"
+)
+
+// Provider implements a provider that serves preset configuration keyed by source name.
+type Provider struct {
+	data  map[string]map[string]string
+	mutex sync.RWMutex
+}
+
+// NewProvider creates a new memory provider with the given configuration per source.
+// The data is copied, so later changes to the argument do not affect the provider.
+func NewProvider(data map[string]map[string]string) *Provider {
+	provider := &Provider{
+		data: make(map[string]map[string]string, len(data)),
+	}
+
+	for source, config := range data {
+		provider.data[source] = copyConfig(config)
+	}
+
+	return provider
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// Load returns a copy of the configuration registered for the source.
+func (p *Provider) Load(ctx context.Context, source string) (map[string]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	config, exists := p.data[source]
+	if !exists {
+		return nil, fmt.Errorf("source not found: %s", source)
+	}
+
+	return copyConfig(config), nil
+}
+
+// Validate validates that the source is registered.
+func (p *Provider) Validate(source string) error {
+	if strings.TrimSpace(source) == "" {
+		return fmt.Errorf("source cannot be empty")
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if _, exists := p.data[source]; !exists {
+		return fmt.Errorf("source not found: %s", source)
+	}
+
+	return nil
+}
+
+// Set registers or replaces the configuration for a source.
+func (p *Provider) Set(source string, config map[string]string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.data[source] = copyConfig(config)
+}
+
+// Sources returns the registered source names.
+func (p *Provider) Sources() []string {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	sources := make([]string, 0, len(p.data))
+	for source := range p.data {
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+// copyConfig returns a shallow copy of a configuration map.
+func copyConfig(config map[string]string) map[string]string {
+	copied := make(map[string]string, len(config))
+	for key, value := range config {
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,60 @@
+// Package openbao provides an OpenBao provider for go-envsync. OpenBao is an API-compatible
+// fork of HashiCorp Vault, so the provider shares the Vault KV loader and only differs in
+// its defaults.
+// This is currently a stub implementation that will be completed when
+// Vault API dependencies are added to the project.
+package openbao
+
+import (
+	"github.com/Gosayram/go-envsync/pkg/providers/internal/kv"
+)
+
+// Constants for OpenBao provider
+const (
+	// ProviderName is the name of the OpenBao provider.
+	ProviderName = "openbao"
+
+	// ProviderAlias is the short alias for the provider, after the bao CLI.
+	ProviderAlias = "bao"
+
+	// DefaultBaoAddr is the default OpenBao server address.
+	DefaultBaoAddr = "http://127.0.0.1:8200"
+
+	// DefaultMaxRetries for failed OpenBao requests, passed to the retry helper's Config.
+	DefaultMaxRetries = 3
+
+	// DefaultMountPath is the default mount path for the OpenBao KV engine.
+	DefaultMountPath = kv.DefaultMountPath
+
+	// MaxKVVersion is the highest supported version of the OpenBao KV secrets engine.
+	MaxKVVersion = kv.MaxKVVersion
+)
+
+// backend describes OpenBao to the shared KV loader.
+var backend = kv.Backend{
+	Name:              ProviderName,
+	DefaultAddress:    DefaultBaoAddr,
+	DefaultMaxRetries: DefaultMaxRetries,
+}
+
+// Provider implements the OpenBao provider on top of the shared KV loader.
+// This is currently a stub implementation.
+type Provider struct {
+	*kv.Loader
+}
+
+// NewProvider creates a new OpenBao provider with default configuration.
+func NewProvider() (*Provider, error) {
+	return NewProviderWithConfig("", "", "")
+}
+
+// NewProviderWithConfig creates a new OpenBao provider with custom configuration.
+// Empty address and mount path default to DefaultBaoAddr and DefaultMountPath.
+func NewProviderWithConfig(addr, _ /* token */, mountPath string) (*Provider, error) {
+	return &Provider{Loader: kv.NewLoader(backend, addr, mountPath)}, nil
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return ProviderName
+}
@@ -0,0 +1,227 @@
+// Package plugin provides a provider backed by an out-of-process plugin binary for go-envsync.
+// The plugin is spawned for each request and speaks a small line-delimited JSON protocol over
+// stdin and stdout: a handshake negotiating the protocol version, followed by a single load or
+// validate request. A gRPC transport is not used so that plugins and go-envsync stay free of
+// RPC dependencies; plugins written in Go can implement the protocol with Serve.
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Constants for the plugin provider
+const (
+	// ProviderName is the default name of the plugin provider.
+	ProviderName = "plugin"
+
+	// ProtocolVersion is the plugin protocol version spoken by this package.
+	ProtocolVersion = 1
+
+	// MagicCookieKey is the environment variable set for plugin processes.
+	MagicCookieKey = "ENVSYNC_PLUGIN_MAGIC_COOKIE"
+
+	// MagicCookieValue is the value of MagicCookieKey. Plugins refuse to serve without it,
+	// which keeps them from being run directly by accident.
+	MagicCookieValue = "d6f2c1a8-envsync-plugin"
+
+	// MethodHandshake is the request method negotiating the protocol version.
+	MethodHandshake = "handshake"
+
+	// MethodLoad is the request method loading configuration from a source.
+	MethodLoad = "load"
+
+	// MethodValidate is the request method validating a source. Provider.Validate does not
+	// send it; Serve answers it for hosts that do.
+	MethodValidate = "validate"
+
+	// MaxResponseSize defines the maximum size of a single plugin response in bytes.
+	MaxResponseSize = 10 * 1024 * 1024
+)
+
+// Request is a message sent to a plugin.
+type Request struct {
+	Method          string `json:"method"`
+	ProtocolVersion int    `json:"protocol_version,omitempty"`
+	Source          string `json:"source,omitempty"`
+}
+
+// Response is a message returned by a plugin.
+type Response struct {
+	ProtocolVersion int               `json:"protocol_version,omitempty"`
+	Name            string            `json:"name,omitempty"`
+	Data            map[string]string `json:"data,omitempty"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// Provider implements a provider that delegates to a plugin binary.
+type Provider struct {
+	name   string
+	binary string
+	args   []string
+}
+
+// NewProvider creates a new plugin provider registered as name that runs the binary with args.
+// An empty name defaults to ProviderName.
+func NewProvider(name, binary string, args ...string) (*Provider, error) {
+	if strings.TrimSpace(binary) == "" {
+		return nil, fmt.Errorf("plugin binary cannot be empty")
+	}
+	if name == "" {
+		name = ProviderName
+	}
+
+	return &Provider{
+		name:   name,
+		binary: binary,
+		args:   append([]string(nil), args...),
+	}, nil
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Load asks the plugin to load configuration from the source.
+func (p *Provider) Load(ctx context.Context, source string) (map[string]string, error) {
+	response, err := p.call(ctx, Request{Method: MethodLoad, Source: source})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Data == nil {
+		return make(map[string]string), nil
+	}
+	return response.Data, nil
+}
+
+// Validate checks locally that the source is not empty and the plugin binary exists.
+// The plugin is not started: Validate has no context to bound it, and Load reports any
+// error of the plugin for the source.
+func (p *Provider) Validate(source string) error {
+	if strings.TrimSpace(source) == "" {
+		return fmt.Errorf("source cannot be empty")
+	}
+
+	// A missing binary is not wrapped, so it does not read as a missing optional source
+	if _, err := exec.LookPath(p.binary); err != nil {
+		return fmt.Errorf("plugin binary %s not found: %v", p.binary, err)
+	}
+	return nil
+}
+
+// HealthCheck starts the plugin and completes the handshake.
+func (p *Provider) HealthCheck(ctx context.Context) error {
+	_, err := p.call(ctx, Request{Method: MethodHandshake, ProtocolVersion: ProtocolVersion})
+	return err
+}
+
+// TestConnection starts the plugin and completes the handshake.
+func (p *Provider) TestConnection(ctx context.Context) error {
+	return p.HealthCheck(ctx)
+}
+
+// call spawns the plugin, performs the handshake and sends the request.
+func (p *Provider) call(ctx context.Context, request Request) (*Response, error) {
+	// #nosec G204 - binary is configured by the caller and arguments are not shell-interpreted
+	cmd := exec.CommandContext(ctx, p.binary, p.args...)
+	cmd.Env = append(os.Environ(), MagicCookieKey+"="+MagicCookieValue)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin stdin: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin stdout: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("plugin binary %s not found in PATH", p.binary)
+		}
+		return nil, fmt.Errorf("failed to start plugin %s: %w", p.binary, err)
+	}
+
+	response, exchangeErr := exchange(stdin, stdout, request)
+	stdin.Close()
+	waitErr := cmd.Wait()
+
+	if exchangeErr != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("plugin %s: %w: %s", p.name, exchangeErr, message)
+		}
+		return nil, fmt.Errorf("plugin %s: %w", p.name, exchangeErr)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", p.name, response.Error)
+	}
+	if waitErr != nil {
+		return nil, fmt.Errorf("plugin %s exited with error: %w", p.name, waitErr)
+	}
+
+	return response, nil
+}
+
+// exchange performs the handshake and, unless the request is itself a handshake,
+// sends the request and reads its response.
+func exchange(w io.Writer, r io.Reader, request Request) (*Response, error) {
+	encoder := json.NewEncoder(w)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), MaxResponseSize)
+
+	// Negotiate protocol version
+	handshake, err := roundTrip(encoder, scanner, Request{Method: MethodHandshake, ProtocolVersion: ProtocolVersion})
+	if err != nil {
+		return nil, fmt.Errorf("handshake failed: %w", err)
+	}
+	if handshake.Error != "" {
+		return nil, fmt.Errorf("handshake failed: %s", handshake.Error)
+	}
+	if handshake.ProtocolVersion != ProtocolVersion {
+		return nil, fmt.Errorf("incompatible plugin protocol version %d, expected %d",
+			handshake.ProtocolVersion, ProtocolVersion)
+	}
+	if handshake.Name == "" {
+		return nil, fmt.Errorf("handshake failed: plugin did not report a provider name")
+	}
+
+	if request.Method == MethodHandshake {
+		return handshake, nil
+	}
+
+	return roundTrip(encoder, scanner, request)
+}
+
+// roundTrip writes a request and reads one response line.
+func roundTrip(encoder *json.Encoder, scanner *bufio.Scanner, request Request) (*Response, error) {
+	if err := encoder.Encode(request); err != nil {
+		return nil, fmt.Errorf("failed to send %s request: %w", request.Method, err)
+	}
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read %s response: %w", request.Method, err)
+		}
+		return nil, fmt.Errorf("plugin closed the connection before responding to %s", request.Method)
+	}
+
+	var response Response
+	if err := json.Unmarshal(scanner.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("invalid %s response: %w", request.Method, err)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+)
+
+// Serve runs a plugin that answers requests on stdin and stdout using the given provider.
+// It returns an error when the process was not started by go-envsync.
+func Serve(provider client.Provider) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return fmt.Errorf("this binary is a go-envsync plugin and is not meant to be run directly")
+	}
+
+	return ServeConn(context.Background(), provider, os.Stdin, os.Stdout)
+}
+
+// ServeConn answers plugin requests read from r until it is closed, writing responses to w.
+func ServeConn(ctx context.Context, provider client.Provider, r io.Reader, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), MaxResponseSize)
+	negotiated := false
+
+	for scanner.Scan() {
+		var request Request
+		if err := json.Unmarshal(scanner.Bytes(), &request); err != nil {
+			return fmt.Errorf("invalid request: %w", err)
+		}
+
+		response := handleRequest(ctx, provider, request, negotiated)
+		if request.Method == MethodHandshake && response.Error == "" {
+			negotiated = true
+		}
+
+		if err := encoder.Encode(response); err != nil {
+			return fmt.Errorf("failed to send response: %w", err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read request: %w", err)
+	}
+	return nil
+}
+
+// handleRequest dispatches a single request to the provider.
+func handleRequest(ctx context.Context, provider client.Provider, request Request, negotiated bool) Response {
+	switch {
+	case request.Method == MethodHandshake:
+		if request.ProtocolVersion != ProtocolVersion {
+			return Response{
+				ProtocolVersion: ProtocolVersion,
+				Error: fmt.Sprintf("unsupported protocol version %d, plugin speaks %d",
+					request.ProtocolVersion, ProtocolVersion),
+			}
+		}
+		return Response{ProtocolVersion: ProtocolVersion, Name: provider.Name()}
+	case !negotiated:
+		return Response{Error: "handshake required before " + request.Method}
+	case request.Method == MethodLoad:
+		data, err := provider.Load(ctx, request.Source)
+		if err != nil {
+			return Response{Error: err.Error()}
+		}
+		return Response{Data: data}
+	case request.Method == MethodValidate:
+		if err := provider.Validate(request.Source); err != nil {
+			return Response{Error: err.Error()}
+		}
+		return Response{}
+	default:
+		return Response{Error: "unknown method " + request.Method}
+	}
+}
@@ -0,0 +1,196 @@
+package registry
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigType is the expected type of a provider configuration value.
+type ConfigType string
+
+// Provider configuration value types
+const (
+	// ConfigTypeString accepts string values.
+	ConfigTypeString ConfigType = "string"
+
+	// ConfigTypeInt accepts integers, including integral float64 values decoded from JSON
+	// and decimal strings such as the key=value assignments of the CLI.
+	ConfigTypeInt ConfigType = "int"
+
+	// ConfigTypeBool accepts boolean values and the strings strconv.ParseBool accepts.
+	ConfigTypeBool ConfigType = "bool"
+
+	// ConfigTypeDuration accepts duration strings ("45s"), time.Duration, or numbers of seconds.
+	ConfigTypeDuration ConfigType = "duration"
+)
+
+// IntRange is an inclusive range of allowed integer values.
+type IntRange struct {
+	Min int `json:"min" yaml:"min"`
+	Max int `json:"max" yaml:"max"`
+}
+
+// ConfigField describes the type and constraints of a provider configuration value.
+type ConfigField struct {
+	// Type is the expected value type.
+	Type ConfigType `json:"type" yaml:"type"`
+
+	// Enum lists the allowed values of a string field. Empty allows any string.
+	Enum []string `json:"enum,omitempty" yaml:"enum,omitempty"`
+
+	// Range bounds the value of an int field. Nil allows any integer.
+	Range *IntRange `json:"range,omitempty" yaml:"range,omitempty"`
+
+	// NotEmpty rejects empty or whitespace-only strings.
+	NotEmpty bool `json:"not_empty,omitempty" yaml:"not_empty,omitempty"`
+}
+
+// validateConfigSchema checks every configured key that has a ConfigSchema entry.
+// Keys without an entry are not checked. All violations are reported together.
+func validateConfigSchema(schema map[string]ConfigField, config map[string]interface{}) error {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		if _, described := schema[key]; described {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var violations []string
+	for _, key := range keys {
+		if err := schema[key].validate(config[key]); err != nil {
+			violations = append(violations, fmt.Sprintf("%s: %v", key, err))
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("invalid configuration: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// validate checks a single configuration value against the field.
+func (f ConfigField) validate(value interface{}) error {
+	switch f.Type {
+	case ConfigTypeString:
+		text, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected string, got %T", value)
+		}
+		if f.NotEmpty && strings.TrimSpace(text) == "" {
+			return fmt.Errorf("must not be empty")
+		}
+		if len(f.Enum) > 0 && !containsString(f.Enum, text) {
+			return fmt.Errorf("must be one of %s, got %q", strings.Join(f.Enum, ", "), text)
+		}
+	case ConfigTypeInt:
+		number, ok := intValue(value)
+		if !ok {
+			return fmt.Errorf("expected integer, got %s", describeValue(value))
+		}
+		if f.Range != nil && (number < f.Range.Min || number > f.Range.Max) {
+			return fmt.Errorf("must be between %d and %d, got %d", f.Range.Min, f.Range.Max, number)
+		}
+	case ConfigTypeBool:
+		if _, ok := boolValue(value); !ok {
+			return fmt.Errorf("expected bool, got %s", describeValue(value))
+		}
+	case ConfigTypeDuration:
+		if _, err := durationValue(value); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown config type %s", f.Type)
+	}
+
+	return nil
+}
+
+// durationValue converts a duration string ("45s"), a time.Duration, or a number of seconds
+// to a non-negative duration.
+func durationValue(value interface{}) (time.Duration, error) {
+	var duration time.Duration
+	switch typed := value.(type) {
+	case string:
+		parsed, err := time.ParseDuration(typed)
+		if err != nil {
+			return 0, err
+		}
+		duration = parsed
+	case time.Duration:
+		duration = typed
+	case int:
+		duration = time.Duration(typed) * time.Second
+	case float64:
+		duration = time.Duration(typed * float64(time.Second))
+	default:
+		return 0, fmt.Errorf("expected duration, got %T", value)
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("must not be negative")
+	}
+	return duration, nil
+}
+
+// intValue converts integer-like values, including integral float64 values and decimal
+// strings, to int.
+func intValue(value interface{}) (int, bool) {
+	switch typed := value.(type) {
+	case int:
+		return typed, true
+	case int64:
+		return int(typed), true
+	case float64:
+		if typed != math.Trunc(typed) {
+			return 0, false
+		}
+		return int(typed), true
+	case string:
+		number, err := strconv.Atoi(strings.TrimSpace(typed))
+		if err != nil {
+			return 0, false
+		}
+		return number, true
+	default:
+		return 0, false
+	}
+}
+
+// boolValue converts booleans and the strings strconv.ParseBool accepts to bool.
+func boolValue(value interface{}) (bool, bool) {
+	switch typed := value.(type) {
+	case bool:
+		return typed, true
+	case string:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(typed))
+		if err != nil {
+			return false, false
+		}
+		return parsed, true
+	default:
+		return false, false
+	}
+}
+
+// describeValue describes a rejected value: strings are quoted, other values named by type.
+func describeValue(value interface{}) string {
+	if text, ok := value.(string); ok {
+		return strconv.Quote(text)
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// containsString reports whether values contains value.
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package registry
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+)
+
+// configProbe records the configuration its factory read.
+type configProbe struct {
+	retries int
+	verbose bool
+}
+
+// Name returns the provider name.
+func (p *configProbe) Name() string {
+	return "probe"
+}
+
+// Load returns no configuration.
+func (p *configProbe) Load(_ context.Context, _ string) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// Validate accepts every source.
+func (p *configProbe) Validate(_ string) error {
+	return nil
+}
+
+// newProbeRegistry returns a registry with a probe provider reading typed configuration.
+func newProbeRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	r := NewRegistry()
+	err := r.Register(&ProviderInfo{
+		Name: "probe",
+		Factory: func(config map[string]interface{}) (client.Provider, error) {
+			retries, _, err := ConfigInt(config, "retries")
+			if err != nil {
+				return nil, err
+			}
+			verbose, _, err := ConfigBool(config, "verbose")
+			if err != nil {
+				return nil, err
+			}
+			return &configProbe{retries: retries, verbose: verbose}, nil
+		},
+		ConfigSchema: map[string]ConfigField{
+			"retries": {Type: ConfigTypeInt, Range: &IntRange{Min: 0, Max: 10}},
+			"verbose": {Type: ConfigTypeBool},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	return r
+}
+
+func TestCreateProviderAcceptsStringConfig(t *testing.T) {
+	r := newProbeRegistry(t)
+
+	// Values as parsed from key=value assignments on the command line
+	provider, err := r.CreateProvider("probe", map[string]interface{}{"retries": "3", "verbose": "true"})
+	if err != nil {
+		t.Fatalf("CreateProvider() error = %v", err)
+	}
+
+	probe := provider.(*configProbe)
+	if probe.retries != 3 || !probe.verbose {
+		t.Errorf("CreateProvider() read retries=%d verbose=%t, want 3 and true", probe.retries, probe.verbose)
+	}
+}
+
+func TestCreateProviderRejectsInvalidConfig(t *testing.T) {
+	r := newProbeRegistry(t)
+
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		want   string
+	}{
+		{"non-numeric string", map[string]interface{}{"retries": "three"}, `expected integer, got "three"`},
+		{"fractional number", map[string]interface{}{"retries": 1.5}, "expected integer, got float64"},
+		{"string out of range", map[string]interface{}{"retries": "11"}, "must be between 0 and 10, got 11"},
+		{"non-boolean string", map[string]interface{}{"verbose": "maybe"}, `expected bool, got "maybe"`},
+		{"wrong type", map[string]interface{}{"verbose": 1}, "expected bool, got int"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.CreateProvider("probe", tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("CreateProvider() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/Gosayram/go-envsync/pkg/client"
+)
+
+// RegisterOption sets optional metadata of a provider registered with RegisterProvider.
+type RegisterOption func(info *ProviderInfo)
+
+// WithAliases sets the alternative names of the provider.
+func WithAliases(aliases ...string) RegisterOption {
+	return func(info *ProviderInfo) {
+		info.Aliases = append([]string(nil), aliases...)
+	}
+}
+
+// WithPriority sets the provider priority (lower = higher priority).
+func WithPriority(priority int) RegisterOption {
+	return func(info *ProviderInfo) {
+		info.Priority = priority
+	}
+}
+
+// WithDescription sets the human-readable description of the provider.
+func WithDescription(description string) RegisterOption {
+	return func(info *ProviderInfo) {
+		info.Description = description
+	}
+}
+
+// WithSupportedSources sets the example source formats listed for the provider.
+func WithSupportedSources(sources ...string) RegisterOption {
+	return func(info *ProviderInfo) {
+		info.SupportedSources = append([]string(nil), sources...)
+	}
+}
+
+// RegisterProvider registers a ready provider instance under name. The factory it is
+// wrapped in ignores its configuration and returns the same instance every time, so the
+// provider must be safe for concurrent use if loaded concurrently.
+func (r *Registry) RegisterProvider(name string, provider client.Provider, opts ...RegisterOption) error {
+	if provider == nil {
+		return fmt.Errorf("provider cannot be nil")
+	}
+
+	info := &ProviderInfo{
+		Name:        name,
+		Description: fmt.Sprintf("Custom provider %s", name),
+		Factory: func(_ map[string]interface{}) (client.Provider, error) {
+			return provider, nil
+		},
+	}
+	for _, opt := range opts {
+		opt(info)
+	}
+
+	return r.Register(info)
+}
+
+// RegisterProvider registers a ready provider instance with the global registry.
+func RegisterProvider(name string, provider client.Provider, opts ...RegisterOption) error {
+	return globalRegistry.RegisterProvider(name, provider, opts...)
+}
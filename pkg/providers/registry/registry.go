@@ -3,8 +3,10 @@ package registry
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Gosayram/go-envsync/pkg/client"
 )
@@ -22,6 +24,9 @@ const (
 
 	// LowPriority for low-priority providers.
 	LowPriority = 90
+
+	// TimeoutConfigKey is the configuration key holding a provider's load timeout.
+	TimeoutConfigKey = "timeout"
 )
 
 // ProviderFactory is a function that creates a new provider instance.
@@ -30,28 +35,32 @@ type ProviderFactory func(config map[string]interface{}) (client.Provider, error
 // ProviderInfo contains information about a registered provider.
 type ProviderInfo struct {
 	// Name is the provider name.
-	Name string
+	Name string `json:"name" yaml:"name"`
 
 	// Aliases are alternative names for the provider.
-	Aliases []string
+	Aliases []string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
 
 	// Factory is the function to create provider instances.
-	Factory ProviderFactory
+	Factory ProviderFactory `json:"-" yaml:"-"`
 
 	// Priority defines the provider priority (lower = higher priority).
-	Priority int
+	Priority int `json:"priority" yaml:"priority"`
 
 	// Description is a human-readable description of the provider.
-	Description string
+	Description string `json:"description" yaml:"description"`
 
 	// SupportedSources lists the supported source formats.
-	SupportedSources []string
+	SupportedSources []string `json:"supported_sources,omitempty" yaml:"supported_sources,omitempty"`
 
 	// RequiredConfig lists the required configuration keys.
-	RequiredConfig []string
+	RequiredConfig []string `json:"required_config,omitempty" yaml:"required_config,omitempty"`
 
 	// OptionalConfig lists the optional configuration keys.
-	OptionalConfig []string
+	OptionalConfig []string `json:"optional_config,omitempty" yaml:"optional_config,omitempty"`
+
+	// ConfigSchema describes the type and constraints of configuration values, checked
+	// before the factory is called. Keys without an entry are not type-checked.
+	ConfigSchema map[string]ConfigField `json:"config_schema,omitempty" yaml:"config_schema,omitempty"`
 }
 
 // Registry manages provider registration and creation.
@@ -125,6 +134,70 @@ func (r *Registry) Register(info *ProviderInfo) error {
 	return nil
 }
 
+// UpdateProvider atomically replaces a registered provider, including its factory and aliases.
+// Aliases are validated as in Register before anything is changed; aliases dropped from the
+// new info are released. It fails when no provider with info.Name is registered.
+func (r *Registry) UpdateProvider(info *ProviderInfo) error {
+	if info == nil {
+		return fmt.Errorf("provider info cannot be nil")
+	}
+
+	if strings.TrimSpace(info.Name) == "" {
+		return fmt.Errorf("provider name cannot be empty")
+	}
+
+	if info.Factory == nil {
+		return fmt.Errorf("provider factory cannot be nil")
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Check if provider exists
+	existing, exists := r.providers[info.Name]
+	if !exists {
+		return fmt.Errorf("provider %s not found", info.Name)
+	}
+
+	// Validate aliases before changing anything
+	for _, alias := range info.Aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" {
+			continue
+		}
+
+		// Check if alias conflicts with existing provider names
+		if _, exists := r.providers[alias]; exists {
+			return fmt.Errorf("alias %s conflicts with existing provider", alias)
+		}
+
+		// Check if alias belongs to another provider
+		if owner, exists := r.aliases[alias]; exists && owner != info.Name {
+			return fmt.Errorf("alias %s already registered", alias)
+		}
+	}
+
+	// Set default priority if not specified
+	if info.Priority == 0 {
+		info.Priority = DefaultProviderPriority
+	}
+
+	// Replace aliases
+	for _, alias := range existing.Aliases {
+		delete(r.aliases, strings.TrimSpace(alias))
+	}
+	for _, alias := range info.Aliases {
+		if alias = strings.TrimSpace(alias); alias != "" {
+			r.aliases[alias] = info.Name
+		}
+	}
+
+	// Replace provider
+	r.providers[info.Name] = info
+
+	return nil
+}
+
 // Unregister removes a provider from the registry.
 func (r *Registry) Unregister(name string) error {
 	r.mutex.Lock()
@@ -225,6 +298,21 @@ func (r *Registry) ListProviders() []*ProviderInfo {
 	return providers
 }
 
+// ListProvidersByPriority returns all registered providers sorted by ascending priority.
+// Providers with equal priority are sorted by name.
+func (r *Registry) ListProvidersByPriority() []*ProviderInfo {
+	providers := r.ListProviders()
+
+	sort.Slice(providers, func(i, j int) bool {
+		if providers[i].Priority != providers[j].Priority {
+			return providers[i].Priority < providers[j].Priority
+		}
+		return providers[i].Name < providers[j].Name
+	})
+
+	return providers
+}
+
 // GetProviderNames returns a list of all registered provider names and aliases.
 func (r *Registry) GetProviderNames() []string {
 	r.mutex.RLock()
@@ -275,7 +363,56 @@ func (r *Registry) validateConfig(info *ProviderInfo, config map[string]interfac
 		}
 	}
 
-	return nil
+	// Check value types and constraints
+	return validateConfigSchema(info.ConfigSchema, config)
+}
+
+// ConfigTimeout reads the load timeout from a provider configuration. The value may be a
+// duration string ("45s"), a time.Duration, or a number of seconds. It returns zero when unset.
+func ConfigTimeout(config map[string]interface{}) (time.Duration, error) {
+	raw, exists := config[TimeoutConfigKey]
+	if !exists {
+		return 0, nil
+	}
+
+	timeout, err := durationValue(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", TimeoutConfigKey, err)
+	}
+
+	return timeout, nil
+}
+
+// ConfigInt reads an integer from a provider configuration, accepting integral float64 values
+// decoded from JSON and decimal strings. It reports whether the key is set.
+func ConfigInt(config map[string]interface{}, key string) (int, bool, error) {
+	raw, exists := config[key]
+	if !exists {
+		return 0, false, nil
+	}
+
+	value, ok := intValue(raw)
+	if !ok {
+		return 0, true, fmt.Errorf("invalid %s: expected integer, got %s", key, describeValue(raw))
+	}
+
+	return value, true, nil
+}
+
+// ConfigBool reads a boolean from a provider configuration, accepting the strings
+// strconv.ParseBool accepts, such as "true" and "0". It reports whether the key is set.
+func ConfigBool(config map[string]interface{}, key string) (bool, bool, error) {
+	raw, exists := config[key]
+	if !exists {
+		return false, false, nil
+	}
+
+	value, ok := boolValue(raw)
+	if !ok {
+		return false, true, fmt.Errorf("invalid %s: expected bool, got %s", key, describeValue(raw))
+	}
+
+	return value, true, nil
 }
 
 // Global registry instance
@@ -286,6 +423,11 @@ func Register(info *ProviderInfo) error {
 	return globalRegistry.Register(info)
 }
 
+// UpdateProvider replaces a provider in the global registry.
+func UpdateProvider(info *ProviderInfo) error {
+	return globalRegistry.UpdateProvider(info)
+}
+
 // CreateProvider creates a provider using the global registry.
 func CreateProvider(name string, config map[string]interface{}) (client.Provider, error) {
 	return globalRegistry.CreateProvider(name, config)
@@ -301,6 +443,11 @@ func ListProviders() []*ProviderInfo {
 	return globalRegistry.ListProviders()
 }
 
+// ListProvidersByPriority lists all providers in the global registry sorted by priority.
+func ListProvidersByPriority() []*ProviderInfo {
+	return globalRegistry.ListProvidersByPriority()
+}
+
 // IsProviderRegistered checks if a provider is registered in the global registry.
 func IsProviderRegistered(name string) bool {
 	return globalRegistry.IsProviderRegistered(name)
@@ -0,0 +1,173 @@
+// Package retry provides retry with exponential backoff for provider loads.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Constants for retry behavior
+const (
+	// DefaultMaxRetries is the number of retries after the first attempt used by
+	// DefaultConfig. Providers own their defaults and pass them in Config.MaxRetries.
+	DefaultMaxRetries = 3
+
+	// DefaultInitialBackoff is the delay before the first retry.
+	DefaultInitialBackoff = 200 * time.Millisecond
+
+	// DefaultMaxBackoff caps the delay between retries.
+	DefaultMaxBackoff = 5 * time.Second
+
+	// DefaultMultiplier is the factor applied to the delay after each retry.
+	DefaultMultiplier = 2
+)
+
+// LoadFunc loads configuration from a source.
+// Provider.Load method values satisfy this type.
+type LoadFunc func(ctx context.Context, source string) (map[string]string, error)
+
+// Config defines retry behavior.
+type Config struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor applied to the delay after each retry.
+	Multiplier float64
+}
+
+// DefaultConfig returns the default retry configuration.
+func DefaultConfig() Config {
+	return Config{
+		MaxRetries:     DefaultMaxRetries,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+		Multiplier:     DefaultMultiplier,
+	}
+}
+
+// StatusError carries an HTTP status code returned by a remote backend.
+type StatusError struct {
+	// StatusCode is the HTTP status code.
+	StatusCode int
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status %d: %v", e.StatusCode, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// permanentError marks an error as not retryable.
+type permanentError struct {
+	err error
+}
+
+// Error returns the error message.
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks an error as not retryable regardless of its type.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsTransient reports whether an error is worth retrying.
+// Timeouts, 5xx, and 429 responses are transient; auth (401/403), not-found (404),
+// context cancellation, and errors marked Permanent are not.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= http.StatusInternalServerError ||
+			statusErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// LoadWithBackoff calls load, retrying transient failures with exponential backoff.
+// It stops early when ctx is done and returns the last error otherwise.
+func LoadWithBackoff(ctx context.Context, load LoadFunc, source string, config Config) (map[string]string, error) {
+	backoff := config.InitialBackoff
+	if backoff <= 0 {
+		backoff = DefaultInitialBackoff
+	}
+
+	multiplier := config.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultMultiplier
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= config.MaxRetries; attempt++ {
+		result, err := load(ctx, source)
+		if err == nil {
+			return result, nil
+		}
+		lastErr = err
+
+		// Stop on non-retryable errors or when out of attempts
+		if !IsTransient(err) || attempt == config.MaxRetries {
+			break
+		}
+
+		// Wait before the next attempt, honoring cancellation
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("retry aborted after %d attempts: %w (last error: %w)", attempt+1, ctx.Err(), lastErr)
+		case <-timer.C:
+		}
+
+		backoff = time.Duration(float64(backoff) * multiplier)
+		if config.MaxBackoff > 0 && backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+	}
+
+	return nil, lastErr
+}
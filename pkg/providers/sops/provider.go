@@ -0,0 +1,239 @@
+// Package sops provides a provider for SOPS-encrypted files for go-envsync.
+// Decryption is delegated to the sops binary, so keys are resolved through the usual
+// SOPS configuration (age keys, PGP keyring, cloud KMS credentials, .sops.yaml).
+package sops
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+// Constants for SOPS provider
+const (
+	// ProviderName is the name of the SOPS provider.
+	ProviderName = "sops"
+
+	// DefaultBinary is the default sops executable looked up in PATH.
+	DefaultBinary = "sops"
+
+	// MetadataKey is the top-level key holding SOPS metadata in JSON and YAML files.
+	MetadataKey = "sops"
+
+	// DotenvMetadataPrefix prefixes SOPS metadata entries in encrypted .env files.
+	DotenvMetadataPrefix = "sops_"
+
+	// DotenvInputType is the sops input/output type for .env files.
+	DotenvInputType = "dotenv"
+)
+
+// Provider implements a provider that decrypts SOPS-encrypted files before parsing them.
+type Provider struct {
+	basePath string
+	binary   string
+}
+
+// NewProvider creates a new SOPS provider with the current directory as base path.
+func NewProvider() *Provider {
+	return NewProviderWithBase(".")
+}
+
+// NewProviderWithBase creates a new SOPS provider with the specified base path.
+func NewProviderWithBase(basePath string) *Provider {
+	if basePath == "" {
+		basePath = "."
+	}
+
+	return &Provider{
+		basePath: basePath,
+		binary:   DefaultBinary,
+	}
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// Load decrypts a SOPS-encrypted file and parses the plaintext according to its format.
+func (p *Provider) Load(ctx context.Context, source string) (map[string]string, error) {
+	filePath := p.resolveFilePath(source)
+	format := local.DetectFormat(filePath)
+
+	// Read encrypted file
+	data, err := p.readFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for SOPS metadata
+	if !HasMetadata(data, format) {
+		return nil, fmt.Errorf("file is not SOPS-encrypted (no sops metadata found): %s", filePath)
+	}
+
+	// Decrypt with the sops binary
+	plaintext, err := p.decrypt(ctx, filePath, format)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse decrypted content
+	config, err := local.ParseDocument(plaintext, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse decrypted file %s: %w", filePath, err)
+	}
+
+	return config, nil
+}
+
+// Validate validates the source before loading.
+func (p *Provider) Validate(source string) error {
+	if strings.TrimSpace(source) == "" {
+		return fmt.Errorf("source cannot be empty")
+	}
+
+	filePath := p.resolveFilePath(source)
+
+	fileInfo, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return &local.NotFoundError{Path: filePath}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat file %s: %w", filePath, err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return fmt.Errorf("source is not a regular file: %s", filePath)
+	}
+
+	if fileInfo.Size() > local.MaxFileSize {
+		return fmt.Errorf("file too large: %d bytes > %d bytes", fileInfo.Size(), local.MaxFileSize)
+	}
+
+	if _, err := inputType(local.DetectFormat(filePath)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// HealthCheck verifies that the sops binary is available.
+func (p *Provider) HealthCheck(_ context.Context) error {
+	if _, err := exec.LookPath(p.binary); err != nil {
+		return fmt.Errorf("sops binary %s not found in PATH: %w", p.binary, err)
+	}
+	return nil
+}
+
+// SetBinary sets the sops executable to run. An empty value restores the default.
+func (p *Provider) SetBinary(binary string) {
+	if binary == "" {
+		binary = DefaultBinary
+	}
+	p.binary = binary
+}
+
+// HasMetadata reports whether the content carries SOPS metadata for the given format.
+func HasMetadata(data []byte, format string) bool {
+	if format == local.FormatEnv {
+		for _, line := range strings.Split(string(data), "\n") {
+			if strings.HasPrefix(strings.TrimSpace(line), DotenvMetadataPrefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Look for the top-level sops object, which is flattened into sops__* keys
+	raw, err := local.ParseDocument(data, format)
+	if err != nil {
+		return false
+	}
+
+	for key := range raw {
+		if key == MetadataKey || strings.HasPrefix(key, MetadataKey+local.FlattenDelimiter) {
+			return true
+		}
+	}
+	return false
+}
+
+// decrypt runs sops to decrypt the file and returns the plaintext.
+func (p *Provider) decrypt(ctx context.Context, filePath, format string) ([]byte, error) {
+	fileType, err := inputType(format)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G204 - binary is configured by the caller and arguments are not shell-interpreted
+	cmd := exec.CommandContext(ctx, p.binary,
+		"--decrypt", "--input-type", fileType, "--output-type", fileType, filePath)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("sops binary %s not found in PATH: install sops to load encrypted files", p.binary)
+		}
+
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, fmt.Errorf("sops failed to decrypt %s (are the decryption keys available?): %s", filePath, message)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// readFile reads the encrypted file, enforcing the local provider size limit.
+func (p *Provider) readFile(filePath string) ([]byte, error) {
+	fileInfo, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
+		return nil, &local.NotFoundError{Path: filePath}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat file %s: %w", filePath, err)
+	}
+
+	if fileInfo.Size() > local.MaxFileSize {
+		return nil, fmt.Errorf("file too large: %d bytes > %d bytes", fileInfo.Size(), local.MaxFileSize)
+	}
+
+	// #nosec G304 - filePath is validated and resolved from configured sources
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+
+	return data, nil
+}
+
+// resolveFilePath resolves the file path relative to the base path.
+func (p *Provider) resolveFilePath(source string) string {
+	if filepath.IsAbs(source) {
+		return source
+	}
+	return filepath.Join(p.basePath, source)
+}
+
+// inputType maps a file format to the sops --input-type value.
+func inputType(format string) (string, error) {
+	switch format {
+	case local.FormatEnv:
+		return DotenvInputType, nil
+	case local.FormatJSON, local.FormatYAML:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported file format for sops: %s", format)
+	}
+}
@@ -4,10 +4,7 @@
 package vault
 
 import (
-	"context"
-	"fmt"
-	"strings"
-	"time"
+	"github.com/Gosayram/go-envsync/pkg/providers/internal/kv"
 )
 
 // Constants for Vault provider
@@ -16,115 +13,50 @@ const (
 	ProviderName = "vault"
 
 	// DefaultTimeout for Vault operations.
-	DefaultTimeout = 30 * time.Second
+	DefaultTimeout = kv.DefaultTimeout
 
-	// DefaultMaxRetries for failed Vault requests.
+	// DefaultMaxRetries for failed Vault requests, passed to the retry helper's Config.
 	DefaultMaxRetries = 3
 
 	// MaxSecretSize defines the maximum size of a Vault secret.
-	MaxSecretSize = 1048576 // 1MB
+	MaxSecretSize = kv.MaxSecretSize
 
 	// DefaultVaultAddr is the default Vault server address.
 	DefaultVaultAddr = "http://127.0.0.1:8200"
 
 	// DefaultMountPath is the default mount path for the Vault KV engine.
-	DefaultMountPath = "secret"
+	DefaultMountPath = kv.DefaultMountPath
+
+	// MaxKVVersion is the highest supported version of the Vault KV secrets engine.
+	MaxKVVersion = kv.MaxKVVersion
 )
 
-// Provider implements the HashiCorp Vault provider.
+// backend describes HashiCorp Vault to the shared KV loader.
+var backend = kv.Backend{
+	Name:              ProviderName,
+	DefaultAddress:    DefaultVaultAddr,
+	DefaultMaxRetries: DefaultMaxRetries,
+}
+
+// Provider implements the HashiCorp Vault provider on top of the shared KV loader.
 // This is currently a stub implementation.
 type Provider struct {
-	mountPath  string
-	timeout    time.Duration
-	maxRetries int
-	enabled    bool
-	address    string
+	*kv.Loader
 }
 
 // NewProvider creates a new Vault provider with default configuration.
 // Currently returns a disabled stub provider.
 func NewProvider() (*Provider, error) {
-	return &Provider{
-		mountPath:  "secret",
-		timeout:    DefaultTimeout,
-		maxRetries: DefaultMaxRetries,
-		enabled:    false, // Disabled until Vault dependencies are added
-	}, nil
+	return NewProviderWithConfig("", "", "")
 }
 
 // NewProviderWithConfig creates a new Vault provider with custom configuration.
-func NewProviderWithConfig(_ /* addr */, _ /* token */, _ /* mountPath */ string) (*Provider, error) {
-	return &Provider{
-		address:   DefaultVaultAddr,
-		mountPath: DefaultMountPath,
-	}, nil
+// Empty address and mount path default to DefaultVaultAddr and DefaultMountPath.
+func NewProviderWithConfig(addr, _ /* token */, mountPath string) (*Provider, error) {
+	return &Provider{Loader: kv.NewLoader(backend, addr, mountPath)}, nil
 }
 
 // Name returns the provider name.
 func (p *Provider) Name() string {
 	return ProviderName
 }
-
-// Load loads secrets from HashiCorp Vault.
-// This is a stub implementation - actual implementation requires Vault API dependencies.
-func (p *Provider) Load(_ /* ctx */ context.Context, source string) (map[string]string, error) {
-	// Validate source
-	if err := p.Validate(source); err != nil {
-		return nil, err
-	}
-
-	// TODO: Implement actual Vault client integration
-	// For now, return an error indicating the provider is not implemented
-	return nil, fmt.Errorf("vault provider is not yet implemented (would load from: %s)", source)
-}
-
-// Validate validates the source before loading.
-// Currently performs basic validation only.
-func (p *Provider) Validate(source string) error {
-	if !p.enabled {
-		return fmt.Errorf("vault provider is not yet implemented")
-	}
-
-	// Check if source is empty
-	if strings.TrimSpace(source) == "" {
-		return fmt.Errorf("source path cannot be empty")
-	}
-
-	// Check if path is valid
-	if strings.Contains(source, "..") {
-		return fmt.Errorf("invalid path (contains ..): %s", source)
-	}
-
-	return nil
-}
-
-// SetTimeout sets the timeout for Vault operations.
-func (p *Provider) SetTimeout(timeout time.Duration) {
-	p.timeout = timeout
-}
-
-// SetMaxRetries sets the maximum number of retries for failed requests.
-func (p *Provider) SetMaxRetries(maxRetries int) {
-	if maxRetries < 0 {
-		maxRetries = 0
-	}
-	p.maxRetries = maxRetries
-}
-
-// SetMountPath sets the mount path for the Vault KV engine.
-func (p *Provider) SetMountPath(mountPath string) {
-	if mountPath == "" {
-		mountPath = "secret"
-	}
-	p.mountPath = mountPath
-}
-
-// GetMountPath returns the current mount path.
-func (p *Provider) GetMountPath() string {
-	return p.mountPath
-}
-
-// IsEnabled returns true if the provider is enabled and ready to use.
-func (p *Provider) IsEnabled() bool {
-	return p.enabled
-}
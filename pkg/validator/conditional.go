@@ -0,0 +1,60 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ConditionalRule requires keys only when another key has a given value, for example
+// AWS_REGION when BACKEND=s3. It validates the whole configuration, so it implements
+// Validator rather than ValidationRule; combine several with CompositeValidator.
+type ConditionalRule struct {
+	ifKey        string
+	equals       string
+	thenRequired []string
+}
+
+// NewConditionalRule creates a rule requiring thenRequired keys whenever ifKey equals the value.
+func NewConditionalRule(ifKey, equals string, thenRequired ...string) *ConditionalRule {
+	return &ConditionalRule{
+		ifKey:        ifKey,
+		equals:       equals,
+		thenRequired: append([]string(nil), thenRequired...),
+	}
+}
+
+// Name returns the rule name.
+func (r *ConditionalRule) Name() string {
+	return fmt.Sprintf("require-if:%s=%s", r.ifKey, r.equals)
+}
+
+// Validate reports the required keys that are missing or empty while the condition holds.
+func (r *ConditionalRule) Validate(_ context.Context, config map[string]string) error {
+	if value, exists := config[r.ifKey]; !exists || value != r.equals {
+		return nil
+	}
+
+	var missing []string
+	var fields []FieldError
+	for _, key := range r.thenRequired {
+		if config[key] == "" {
+			missing = append(missing, key)
+			fields = append(fields, FieldError{
+				Key:     key,
+				Value:   config[key],
+				Rule:    r.Name(),
+				Message: fmt.Sprintf("required when %s=%s", r.ifKey, r.equals),
+			})
+		}
+	}
+
+	if len(missing) > 0 {
+		return &ValidationError{
+			Fields:  fields,
+			summary: fmt.Sprintf("%s=%s requires %s", r.ifKey, r.equals, strings.Join(missing, ", ")),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// SchemaDefaults reads the default values declared by a JSON schema's top-level properties.
+// Strings are returned as is; numbers, booleans and other JSON values use their JSON text.
+// Properties without a default, or with a null default, are omitted.
+func SchemaDefaults(schemaPath string) (map[string]string, error) {
+	if schemaPath == "" {
+		schemaPath = DefaultSchemaFile
+	}
+
+	// #nosec G304 - schemaPath is provided by the caller
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+
+	var schema struct {
+		Properties map[string]struct {
+			Default json.RawMessage `json:"default"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema %s: %w", schemaPath, err)
+	}
+
+	defaults := make(map[string]string)
+	for key, property := range schema.Properties {
+		raw := strings.TrimSpace(string(property.Default))
+		if raw == "" || raw == "null" {
+			continue
+		}
+
+		var text string
+		if err := json.Unmarshal(property.Default, &text); err == nil {
+			defaults[key] = text
+			continue
+		}
+		defaults[key] = raw
+	}
+
+	return defaults, nil
+}
+
+// SchemaRequired reads the keys listed in a JSON schema's top-level required array, in sorted order.
+func SchemaRequired(schemaPath string) ([]string, error) {
+	if schemaPath == "" {
+		schemaPath = DefaultSchemaFile
+	}
+
+	// #nosec G304 - schemaPath is provided by the caller
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+
+	var schema struct {
+		Required []string `json:"required"`
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema %s: %w", schemaPath, err)
+	}
+
+	required := append([]string(nil), schema.Required...)
+	sort.Strings(required)
+	return required, nil
+}
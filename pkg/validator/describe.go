@@ -0,0 +1,159 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SchemaKey describes a configuration key declared by a JSON schema.
+type SchemaKey struct {
+	// Key is the property name.
+	Key string `json:"key" yaml:"key"`
+
+	// Type is the declared JSON type, with alternatives joined by "|", or empty when untyped.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+
+	// Required reports whether the key is listed in the schema's required array.
+	Required bool `json:"required" yaml:"required"`
+
+	// Declared reports whether the key is described in properties. Keys that are only
+	// required are not declared.
+	Declared bool `json:"declared" yaml:"declared"`
+
+	// Description is the property description.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Default is the JSON text of the property default, or empty.
+	Default string `json:"default,omitempty" yaml:"default,omitempty"`
+}
+
+// schemaDocument is the part of a JSON schema describing top-level configuration keys.
+type schemaDocument struct {
+	Type       json.RawMessage `json:"type"`
+	Properties map[string]struct {
+		Type        json.RawMessage `json:"type"`
+		Description string          `json:"description"`
+		Default     json.RawMessage `json:"default"`
+	} `json:"properties"`
+	PatternProperties map[string]json.RawMessage `json:"patternProperties"`
+	Required          []string                   `json:"required"`
+}
+
+// readSchemaDocument reads the top-level keys of a JSON schema.
+func readSchemaDocument(schemaPath string) (*schemaDocument, error) {
+	// #nosec G304 - schemaPath is provided by the caller
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+
+	var document schemaDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse schema %s: %w", schemaPath, err)
+	}
+	return &document, nil
+}
+
+// DescribeSchema lists the keys a JSON schema declares or requires, sorted by key.
+func DescribeSchema(schemaPath string) ([]SchemaKey, error) {
+	document, err := readSchemaDocument(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*SchemaKey, len(document.Properties))
+	for name, property := range document.Properties {
+		key := &SchemaKey{
+			Key:         name,
+			Type:        schemaType(property.Type),
+			Declared:    true,
+			Description: property.Description,
+		}
+		if raw := strings.TrimSpace(string(property.Default)); raw != "" && raw != "null" {
+			key.Default = raw
+		}
+		keys[name] = key
+	}
+	for _, name := range document.Required {
+		if _, exists := keys[name]; !exists {
+			keys[name] = &SchemaKey{Key: name}
+		}
+		keys[name].Required = true
+	}
+
+	described := make([]SchemaKey, 0, len(keys))
+	for _, key := range keys {
+		described = append(described, *key)
+	}
+	sort.Slice(described, func(i, j int) bool {
+		return described[i].Key < described[j].Key
+	})
+
+	return described, nil
+}
+
+// CheckSchema loads a JSON schema the way NewSchemaValidator does, without validating any
+// configuration, and checks that it can describe a configuration: the top-level type, if
+// set, must allow objects and pattern properties must compile. It returns warnings for
+// suspicious but valid declarations, such as required keys missing from properties.
+func CheckSchema(schemaPath string) ([]string, error) {
+	schemaValidator, err := NewSchemaValidator(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := readSchemaDocument(schemaValidator.schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if topType := schemaType(document.Type); topType != "" && !strings.Contains("|"+topType+"|", "|object|") {
+		return nil, fmt.Errorf("schema type is %s, but configuration is validated as an object", topType)
+	}
+	for pattern := range document.PatternProperties {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern property %s: %w", pattern, err)
+		}
+	}
+
+	var warnings []string
+	for _, name := range document.Required {
+		if _, declared := document.Properties[name]; !declared && !matchesPatternProperty(document, name) {
+			warnings = append(warnings, fmt.Sprintf("required key %s is not declared in properties", name))
+		}
+	}
+	if len(document.Properties) == 0 && len(document.PatternProperties) == 0 {
+		warnings = append(warnings, "schema declares no properties")
+	}
+	sort.Strings(warnings)
+
+	return warnings, nil
+}
+
+// matchesPatternProperty reports whether a key matches any pattern property of the schema.
+func matchesPatternProperty(document *schemaDocument, key string) bool {
+	for pattern := range document.PatternProperties {
+		if matched, _ := regexp.MatchString(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaType returns a JSON schema type keyword as text, joining alternatives with "|".
+func schemaType(raw json.RawMessage) string {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return single
+	}
+
+	var alternatives []string
+	if err := json.Unmarshal(raw, &alternatives); err == nil {
+		return strings.Join(alternatives, "|")
+	}
+	return ""
+}
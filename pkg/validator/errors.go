@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"errors"
+	"strings"
+)
+
+// Constants for validation errors
+const (
+	// validationFailedPrefix starts the message of every ValidationError.
+	validationFailedPrefix = "configuration validation failed: "
+
+	// RuleKey names failures of the built-in key checks of CustomValidator.
+	RuleKey = "key"
+
+	// RuleValue names failures of the built-in value checks of CustomValidator.
+	RuleValue = "value"
+
+	// RuleUnknownKey names keys rejected because the schema does not describe them.
+	RuleUnknownKey = "unknown-key"
+
+	// RuleExample names keys of a .env.example file missing from the configuration.
+	RuleExample = "example"
+
+	// RuleKeyPolicy names keys rejected by a KeyPolicyValidator.
+	RuleKeyPolicy = "key-policy"
+)
+
+// FieldError describes one failed check of one configuration key.
+type FieldError struct {
+	// Key is the configuration key the check failed for.
+	Key string `json:"key" yaml:"key"`
+
+	// Value is the value of the key, or empty when the key is missing.
+	Value string `json:"value" yaml:"value"`
+
+	// Rule names the check that failed, such as a schema keyword or a rule name.
+	Rule string `json:"rule" yaml:"rule"`
+
+	// Message describes the failure.
+	Message string `json:"message" yaml:"message"`
+
+	// Schema is the schema file that reported the failure when validating against several
+	// schemas, or empty.
+	Schema string `json:"schema,omitempty" yaml:"schema,omitempty"`
+
+	// text is the failure as it appears in the error message
+	text string
+}
+
+// String returns the failure as it appears in the validator's error message.
+func (e FieldError) String() string {
+	if e.text != "" {
+		return e.text
+	}
+	return e.Key + ": " + e.Message
+}
+
+// ValidationError is returned by the validators of this package when the configuration
+// fails one or more checks. Its message lists every failure; Fields holds them in
+// structured form for machine-readable reports.
+type ValidationError struct {
+	// Fields lists the failed checks in the order they were found.
+	Fields []FieldError
+
+	// summary replaces the joined failures in the message when set
+	summary string
+}
+
+// Error returns the failures joined into one message.
+func (e *ValidationError) Error() string {
+	if e.summary != "" {
+		return validationFailedPrefix + e.summary
+	}
+
+	texts := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		texts = append(texts, field.String())
+	}
+	return validationFailedPrefix + strings.Join(texts, "; ")
+}
+
+// FieldErrors returns the structured failures of a validation error, or nil when err does not
+// wrap a ValidationError.
+func FieldErrors(err error) []FieldError {
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	return append([]FieldError(nil), validationErr.Fields...)
+}
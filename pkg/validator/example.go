@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// ExampleValidator checks the configuration against the keys of a .env.example file:
+// every example key must be present, whatever its value. Keys missing from the example
+// are reported as warnings when extra key reporting is enabled.
+type ExampleValidator struct {
+	examplePath string
+	keys        []string
+	warnExtra   bool
+}
+
+// NewExampleValidator creates a validator for the keys declared in the example file.
+func NewExampleValidator(examplePath string) (*ExampleValidator, error) {
+	example, err := godotenv.Read(examplePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read example file %s: %w", examplePath, err)
+	}
+
+	keys := make([]string, 0, len(example))
+	for key := range example {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return &ExampleValidator{
+		examplePath: examplePath,
+		keys:        keys,
+	}, nil
+}
+
+// SetWarnExtraKeys enables warnings for configuration keys not listed in the example.
+func (v *ExampleValidator) SetWarnExtraKeys(warn bool) {
+	v.warnExtra = warn
+}
+
+// Keys returns the keys declared in the example, in sorted order.
+func (v *ExampleValidator) Keys() []string {
+	return append([]string(nil), v.keys...)
+}
+
+// Validate reports the example keys missing from the configuration.
+func (v *ExampleValidator) Validate(ctx context.Context, config map[string]string) error {
+	_, err := v.ValidateWithWarnings(ctx, config)
+	return err
+}
+
+// ValidateWithWarnings reports the example keys missing from the configuration and, when
+// extra key reporting is enabled, returns the keys the example does not list as warnings.
+func (v *ExampleValidator) ValidateWithWarnings(_ context.Context, config map[string]string) ([]string, error) {
+	var missing []string
+	var fields []FieldError
+	declared := make(map[string]bool, len(v.keys))
+	for _, key := range v.keys {
+		declared[key] = true
+		if _, exists := config[key]; !exists {
+			missing = append(missing, key)
+			fields = append(fields, FieldError{
+				Key:     key,
+				Rule:    RuleExample,
+				Message: fmt.Sprintf("key is listed in %s but missing", v.examplePath),
+			})
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, &ValidationError{
+			Fields:  fields,
+			summary: fmt.Sprintf("keys from %s missing: %s", v.examplePath, strings.Join(missing, ", ")),
+		}
+	}
+
+	// Flag keys the example does not declare
+	var warnings []string
+	if v.warnExtra {
+		for key := range config {
+			if !declared[key] {
+				warnings = append(warnings, fmt.Sprintf("key %s is not listed in %s", key, v.examplePath))
+			}
+		}
+		sort.Strings(warnings)
+	}
+
+	return warnings, nil
+}
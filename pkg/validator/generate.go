@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Constants for schema generation
+const (
+	// SchemaDraftURI is the JSON Schema draft used by generated schemas.
+	SchemaDraftURI = "http://json-schema.org/draft-07/schema#"
+
+	// GeneratedSchemaTitle is the title of generated schemas.
+	GeneratedSchemaTitle = "Generated Environment Configuration Schema"
+
+	// IntegerPattern matches integer values.
+	IntegerPattern = `^-?[0-9]+$`
+
+	// NumberPattern matches decimal number values.
+	NumberPattern = `^-?[0-9]+\.[0-9]+$`
+
+	// FormatURI is the JSON Schema format for URLs.
+	FormatURI = "uri"
+
+	// FormatEmail is the JSON Schema format for email addresses.
+	FormatEmail = "email"
+)
+
+var (
+	integerRegexp = regexp.MustCompile(IntegerPattern)
+	numberRegexp  = regexp.MustCompile(NumberPattern)
+)
+
+// SchemaProperty describes a single generated schema property.
+type SchemaProperty struct {
+	Type    string `json:"type"`
+	Format  string `json:"format,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// GeneratedSchema is a starter JSON Schema derived from a configuration map.
+type GeneratedSchema struct {
+	Schema               string                     `json:"$schema"`
+	Title                string                     `json:"title"`
+	Type                 string                     `json:"type"`
+	Properties           map[string]*SchemaProperty `json:"properties"`
+	Required             []string                   `json:"required"`
+	AdditionalProperties *bool                      `json:"additionalProperties,omitempty"`
+}
+
+// GenerateSchema builds a starter JSON Schema from the configuration.
+// Every key becomes a required string property with format/pattern hints inferred from its value.
+// When strict is true, additionalProperties is set to false.
+func GenerateSchema(config map[string]string, strict bool) *GeneratedSchema {
+	schema := &GeneratedSchema{
+		Schema:     SchemaDraftURI,
+		Title:      GeneratedSchemaTitle,
+		Type:       "object",
+		Properties: make(map[string]*SchemaProperty, len(config)),
+		Required:   make([]string, 0, len(config)),
+	}
+
+	for key, value := range config {
+		schema.Properties[key] = inferProperty(value)
+		schema.Required = append(schema.Required, key)
+	}
+	sort.Strings(schema.Required)
+
+	if strict {
+		additional := false
+		schema.AdditionalProperties = &additional
+	}
+
+	return schema
+}
+
+// MarshalSchema renders a generated schema as indented JSON.
+func MarshalSchema(schema *GeneratedSchema) ([]byte, error) {
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	return append(data, '\n'), nil
+}
+
+// inferProperty infers a schema property from an observed value. The value is matched
+// as is, since the generated pattern and format are validated against the raw value too.
+func inferProperty(value string) *SchemaProperty {
+	property := &SchemaProperty{Type: "string"}
+
+	switch {
+	case integerRegexp.MatchString(value):
+		property.Pattern = IntegerPattern
+	case numberRegexp.MatchString(value):
+		property.Pattern = NumberPattern
+	case looksLikeURL(value):
+		property.Format = FormatURI
+	case looksLikeEmail(value):
+		property.Format = FormatEmail
+	}
+
+	return property
+}
+
+// looksLikeURL reports whether the value is an absolute URL with a host.
+func looksLikeURL(value string) bool {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	return parsed.Scheme != "" && parsed.Host != ""
+}
+
+// looksLikeEmail reports whether the value is a bare email address.
+func looksLikeEmail(value string) bool {
+	if !strings.Contains(value, "@") || strings.ContainsAny(value, " <>") {
+		return false
+	}
+
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Address == value
+}
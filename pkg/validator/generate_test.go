@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGeneratedSchemaAcceptsSourceValues(t *testing.T) {
+	config := map[string]string{
+		"PORT":         "8080",
+		"PADDED_PORT":  " 8080 ",
+		"RATIO":        "0.75",
+		"PADDED_RATIO": "0.75\t",
+		"API_URL":      "https://api.example.com/v1",
+		"PADDED_URL":   " https://api.example.com/v1",
+		"ADMIN_EMAIL":  "admin@example.com",
+		"PADDED_EMAIL": "admin@example.com ",
+		"NAME":         "demo",
+	}
+
+	data, err := MarshalSchema(GenerateSchema(config, true))
+	if err != nil {
+		t.Fatalf("MarshalSchema() error = %v", err)
+	}
+	schemaPath := filepath.Join(t.TempDir(), "schema.json")
+	if err := os.WriteFile(schemaPath, data, 0o600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+
+	validator, err := NewSchemaValidator(schemaPath)
+	if err != nil {
+		t.Fatalf("NewSchemaValidator() error = %v", err)
+	}
+	if err := validator.Validate(context.Background(), config); err != nil {
+		t.Errorf("Validate() of the source configuration error = %v\n%s", err, data)
+	}
+}
+
+func TestInferProperty(t *testing.T) {
+	tests := []struct {
+		value   string
+		pattern string
+		format  string
+	}{
+		{value: "42", pattern: IntegerPattern},
+		{value: "-7", pattern: IntegerPattern},
+		{value: " 42"},
+		{value: "3.14", pattern: NumberPattern},
+		{value: "3.14 "},
+		{value: "https://example.com", format: FormatURI},
+		{value: " https://example.com"},
+		{value: "ops@example.com", format: FormatEmail},
+		{value: "ops@example.com\n"},
+		{value: "plain"},
+	}
+
+	for _, tt := range tests {
+		property := inferProperty(tt.value)
+		if property.Pattern != tt.pattern || property.Format != tt.format {
+			t.Errorf("inferProperty(%q) = pattern %q, format %q, want %q, %q",
+				tt.value, property.Pattern, property.Format, tt.pattern, tt.format)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// MultiSchemaValidator validates configuration against several JSON schemas, as if they were
+// combined with allOf. Every schema is checked and all failures are reported, each naming the
+// schema file that produced it.
+type MultiSchemaValidator struct {
+	validators    []*SchemaValidator
+	rejectUnknown bool
+}
+
+// NewMultiSchemaValidator creates a validator for the given schema files. When rejectUnknown is
+// set, keys described by none of the schemas are rejected; a key only needs to be described by
+// one of them.
+func NewMultiSchemaValidator(schemaPaths []string, rejectUnknown bool) (*MultiSchemaValidator, error) {
+	if len(schemaPaths) == 0 {
+		return nil, fmt.Errorf("no schema files given")
+	}
+
+	validators := make([]*SchemaValidator, 0, len(schemaPaths))
+	for _, schemaPath := range schemaPaths {
+		schemaValidator, err := NewSchemaValidator(schemaPath)
+		if err != nil {
+			return nil, fmt.Errorf("schema %s: %w", schemaPath, err)
+		}
+		validators = append(validators, schemaValidator)
+	}
+
+	return &MultiSchemaValidator{
+		validators:    validators,
+		rejectUnknown: rejectUnknown,
+	}, nil
+}
+
+// Validate validates configuration against every schema and collects the failures of all of them.
+func (v *MultiSchemaValidator) Validate(ctx context.Context, config map[string]string) error {
+	var fields []FieldError
+
+	// Check each schema, attributing its failures to the schema file
+	for _, schemaValidator := range v.validators {
+		err := schemaValidator.Validate(ctx, config)
+		if err == nil {
+			continue
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) {
+			return fmt.Errorf("schema %s: %w", schemaValidator.name, err)
+		}
+		for _, field := range validationErr.Fields {
+			field.text = schemaValidator.name + ": " + field.String()
+			field.Schema = schemaValidator.name
+			fields = append(fields, field)
+		}
+	}
+
+	// Reject keys none of the schemas describe
+	if v.rejectUnknown {
+		unknown, err := v.unknownKeys(config)
+		if err != nil {
+			return err
+		}
+		for _, key := range unknown {
+			fields = append(fields, FieldError{
+				Key:     key,
+				Value:   config[key],
+				Rule:    RuleUnknownKey,
+				Message: "key is not described by any schema",
+			})
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: fields}
+}
+
+// unknownKeys returns the sorted configuration keys that no schema describes.
+func (v *MultiSchemaValidator) unknownKeys(config map[string]string) ([]string, error) {
+	counts := make(map[string]int)
+	for _, schemaValidator := range v.validators {
+		// #nosec G304 - schemaPath is resolved from a configured schema path
+		schemaData, err := os.ReadFile(schemaValidator.schemaPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read schema file: %w", err)
+		}
+
+		unknown, err := unknownKeys(schemaData, config)
+		if err != nil {
+			return nil, fmt.Errorf("schema %s: %w", schemaValidator.name, err)
+		}
+		for _, key := range unknown {
+			counts[key]++
+		}
+	}
+
+	var unknown []string
+	for key, count := range counts {
+		if count == len(v.validators) {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown, nil
+}
@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// KeyPolicyValidator is a policy gate on key names, separate from schema validation:
+// keys matching a deny pattern are rejected, and when allow patterns are set, so is every
+// key matching none of them. Patterns are globs with path.Match semantics, such as AWS_*.
+type KeyPolicyValidator struct {
+	allow []string
+	deny  []string
+}
+
+// NewKeyPolicyValidator creates a validator for the allow and deny patterns. Either may be
+// empty; deny takes precedence over allow. It fails on malformed patterns.
+func NewKeyPolicyValidator(allow, deny []string) (*KeyPolicyValidator, error) {
+	for _, pattern := range append(append([]string(nil), allow...), deny...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid key pattern %s: %w", pattern, err)
+		}
+	}
+
+	return &KeyPolicyValidator{
+		allow: append([]string(nil), allow...),
+		deny:  append([]string(nil), deny...),
+	}, nil
+}
+
+// Validate reports the keys the policy forbids, in sorted order.
+func (v *KeyPolicyValidator) Validate(_ context.Context, config map[string]string) error {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var violations []string
+	var fields []FieldError
+	for _, key := range keys {
+		var message string
+		switch {
+		case matchesGlob(v.deny, key):
+			message = "key is denied by policy"
+		case len(v.allow) > 0 && !matchesGlob(v.allow, key):
+			message = "key is not in the policy allowlist"
+		default:
+			continue
+		}
+
+		violations = append(violations, key)
+		fields = append(fields, FieldError{Key: key, Value: config[key], Rule: RuleKeyPolicy, Message: message})
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{
+			Fields:  fields,
+			summary: fmt.Sprintf("keys forbidden by key policy: %s", strings.Join(violations, ", ")),
+		}
+	}
+
+	return nil
+}
+
+// matchesGlob reports whether the key matches any of the glob patterns.
+func matchesGlob(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
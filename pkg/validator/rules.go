@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Constants for key naming conventions
+const (
+	// NamingUpperSnake requires keys like DATABASE_URL. Repeated underscores are allowed so
+	// keys flattened with the default "__" delimiter, like DATABASE__HOST, pass.
+	NamingUpperSnake = "upper_snake"
+
+	// NamingLowerSnake requires keys like database_url, or database__host.
+	NamingLowerSnake = "lower_snake"
+
+	// NamingKebab requires keys like database-url.
+	NamingKebab = "kebab"
+)
+
+// namingPatterns maps each naming convention to the pattern keys must match.
+var namingPatterns = map[string]*regexp.Regexp{
+	NamingUpperSnake: regexp.MustCompile(`^[A-Z][A-Z0-9]*(_+[A-Z0-9]+)*$`),
+	NamingLowerSnake: regexp.MustCompile(`^[a-z][a-z0-9]*(_+[a-z0-9]+)*$`),
+	NamingKebab:      regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+}
+
+// NamingRule is a validation rule that requires keys to follow a naming convention.
+type NamingRule struct {
+	convention string
+	pattern    *regexp.Regexp
+}
+
+// NewNamingRule creates a naming rule for the convention (upper_snake, lower_snake, kebab).
+func NewNamingRule(convention string) (*NamingRule, error) {
+	convention = strings.ToLower(strings.TrimSpace(convention))
+
+	pattern, exists := namingPatterns[convention]
+	if !exists {
+		return nil, fmt.Errorf("unknown key naming convention: %s (valid: %s, %s, %s)",
+			convention, NamingUpperSnake, NamingLowerSnake, NamingKebab)
+	}
+
+	return &NamingRule{
+		convention: convention,
+		pattern:    pattern,
+	}, nil
+}
+
+// Name returns the rule name.
+func (r *NamingRule) Name() string {
+	return "naming:" + r.convention
+}
+
+// Validate checks that the key follows the naming convention.
+func (r *NamingRule) Validate(key, _ string) error {
+	if !r.pattern.MatchString(key) {
+		return fmt.Errorf("key %s is not %s", key, r.convention)
+	}
+	return nil
+}
+
+// EncodingRule is a validation rule that rejects values containing invalid UTF-8 or control
+// characters. Tabs are always allowed; newlines and carriage returns only when multiline
+// values are permitted.
+type EncodingRule struct {
+	allowMultiline bool
+}
+
+// NewEncodingRule creates an encoding rule, allowing line breaks in values when allowMultiline is true.
+func NewEncodingRule(allowMultiline bool) *EncodingRule {
+	return &EncodingRule{
+		allowMultiline: allowMultiline,
+	}
+}
+
+// Name returns the rule name.
+func (r *EncodingRule) Name() string {
+	return "encoding"
+}
+
+// Validate checks that the value is valid UTF-8 without disallowed control characters,
+// reporting the byte offset of the first offending character.
+func (r *EncodingRule) Validate(key, value string) error {
+	for offset := 0; offset < len(value); {
+		char, size := utf8.DecodeRuneInString(value[offset:])
+		if char == utf8.RuneError && size <= 1 {
+			return fmt.Errorf("value of key %s contains invalid UTF-8 at byte offset %d", key, offset)
+		}
+
+		if !r.allowed(char) {
+			return fmt.Errorf("value of key %s contains control character %U at byte offset %d", key, char, offset)
+		}
+
+		offset += size
+	}
+
+	return nil
+}
+
+// allowed reports whether a decoded character may appear in a value.
+func (r *EncodingRule) allowed(char rune) bool {
+	switch char {
+	case '\t':
+		return true
+	case '\n', '\r':
+		return r.allowMultiline
+	default:
+		return !unicode.IsControl(char)
+	}
+}
@@ -0,0 +1,114 @@
+package validator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Gosayram/go-envsync/pkg/providers/local"
+)
+
+func TestEncodingRule(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		allowMultiline bool
+		wantErr        string
+	}{
+		{name: "ascii", value: "plain value"},
+		{name: "multibyte utf-8", value: "héllo ✓ 😀"},
+		{name: "tab", value: "a\tb"},
+		{name: "empty", value: ""},
+		{name: "newline allowed", value: "a\nb\r\n", allowMultiline: true},
+		{name: "newline rejected", value: "ab\nc", wantErr: "control character U+000A at byte offset 2"},
+		{name: "invalid start byte", value: "ok\xffbad", wantErr: "invalid UTF-8 at byte offset 2"},
+		{name: "truncated sequence", value: "caf\xc3", wantErr: "invalid UTF-8 at byte offset 3"},
+		{name: "overlong encoding", value: "\xc0\xafx", wantErr: "invalid UTF-8 at byte offset 0"},
+		{name: "surrogate half", value: "é\xed\xa0\x80", wantErr: "invalid UTF-8 at byte offset 2"},
+		{name: "nul byte", value: "a\x00b", wantErr: "control character U+0000 at byte offset 1"},
+		{name: "escape character", value: "\x1b[31mred", wantErr: "control character U+001B at byte offset 0"},
+		{name: "c1 control", value: "a\u0085", wantErr: "control character U+0085 at byte offset 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewEncodingRule(tt.allowMultiline).Validate("KEY", tt.value)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Validate() = nil, want error containing %q", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) || !strings.Contains(err.Error(), "KEY") {
+				t.Errorf("Validate() error = %q, want key and %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEncodingRuleInCustomValidator(t *testing.T) {
+	v := NewCustomValidator(NewEncodingRule(true))
+
+	if err := v.Validate(context.Background(), map[string]string{"GOOD": "fine\n"}); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+
+	err := v.Validate(context.Background(), map[string]string{"GOOD": "fine", "BINARY": "\x89PNG\x00"})
+	if err == nil {
+		t.Fatal("Validate() = nil, want error for invalid UTF-8")
+	}
+	if !strings.Contains(err.Error(), "BINARY") || strings.Contains(err.Error(), "PNG") {
+		t.Errorf("Validate() error = %q, want the key without the value", err)
+	}
+}
+
+func TestNamingRule(t *testing.T) {
+	tests := []struct {
+		convention string
+		key        string
+		valid      bool
+	}{
+		{convention: NamingUpperSnake, key: "DATABASE_URL", valid: true},
+		{convention: NamingUpperSnake, key: "DATABASE__HOST", valid: true},
+		{convention: NamingUpperSnake, key: "APP__DB__PORT2", valid: true},
+		{convention: NamingUpperSnake, key: "PORT", valid: true},
+		{convention: NamingUpperSnake, key: "database_url", valid: false},
+		{convention: NamingUpperSnake, key: "_LEADING", valid: false},
+		{convention: NamingUpperSnake, key: "TRAILING_", valid: false},
+		{convention: NamingUpperSnake, key: "TRAILING__", valid: false},
+		{convention: NamingUpperSnake, key: "DATABASE.HOST", valid: false},
+		{convention: NamingLowerSnake, key: "database__host", valid: true},
+		{convention: NamingLowerSnake, key: "Database_host", valid: false},
+		{convention: NamingKebab, key: "database-url", valid: true},
+		{convention: NamingKebab, key: "database--url", valid: false},
+	}
+
+	for _, tt := range tests {
+		rule, err := NewNamingRule(tt.convention)
+		if err != nil {
+			t.Fatalf("NewNamingRule(%q) error = %v", tt.convention, err)
+		}
+		if err := rule.Validate(tt.key, "value"); (err == nil) != tt.valid {
+			t.Errorf("%s rule on %q: error = %v, want valid %v", tt.convention, tt.key, err, tt.valid)
+		}
+	}
+}
+
+func TestNamingRuleAcceptsFlattenedKeys(t *testing.T) {
+	rule, err := NewNamingRule(NamingUpperSnake)
+	if err != nil {
+		t.Fatalf("NewNamingRule() error = %v", err)
+	}
+
+	// Keys the local provider produces for nested JSON/YAML with the default "__" delimiter
+	config, err := local.ParseDocument([]byte(`{"DATABASE": {"HOST": "db", "PORT": 5432}}`), local.FormatJSON)
+	if err != nil {
+		t.Fatalf("ParseDocument() error = %v", err)
+	}
+	if err := NewCustomValidator(rule).Validate(context.Background(), config); err != nil {
+		t.Errorf("Validate() of %v error = %v", config, err)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/xeipuuv/gojsonschema"
@@ -29,8 +31,10 @@ const (
 
 // SchemaValidator implements configuration validation using JSON Schema.
 type SchemaValidator struct {
-	schemaPath string
-	schema     *gojsonschema.Schema
+	name          string
+	schemaPath    string
+	schema        *gojsonschema.Schema
+	rejectUnknown bool
 }
 
 // NewSchemaValidator creates a new JSON Schema validator.
@@ -58,11 +62,25 @@ func NewSchemaValidator(schemaPath string) (*SchemaValidator, error) {
 	}
 
 	return &SchemaValidator{
+		name:       schemaPath,
 		schemaPath: absPath,
 		schema:     schema,
 	}, nil
 }
 
+// NewSchemaValidatorStrict creates a JSON Schema validator that also rejects keys not described
+// by the schema's properties or patternProperties, even when the schema does not set
+// additionalProperties to false.
+func NewSchemaValidatorStrict(schemaPath string) (*SchemaValidator, error) {
+	schemaValidator, err := NewSchemaValidator(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaValidator.rejectUnknown = true
+	return schemaValidator, nil
+}
+
 // Validate validates configuration against the JSON schema.
 func (v *SchemaValidator) Validate(_ context.Context, config map[string]string) error {
 	// Check if schema file exists
@@ -102,19 +120,134 @@ func (v *SchemaValidator) Validate(_ context.Context, config map[string]string)
 
 	// Check validation result
 	if !result.Valid() {
-		var errors []string
+		fields := make([]FieldError, 0, len(result.Errors()))
 		for _, desc := range result.Errors() {
-			errors = append(errors, desc.String())
+			fields = append(fields, schemaFieldError(desc, config))
+		}
+		sort.SliceStable(fields, func(i, j int) bool { return fields[i].Key < fields[j].Key })
+		return &ValidationError{Fields: fields}
+	}
+
+	// Reject keys not described by the schema
+	if v.rejectUnknown {
+		unknown, err := unknownKeys(schemaData, config)
+		if err != nil {
+			return err
+		}
+		if len(unknown) > 0 {
+			fields := make([]FieldError, 0, len(unknown))
+			for _, key := range unknown {
+				fields = append(fields, FieldError{
+					Key:     key,
+					Value:   config[key],
+					Rule:    RuleUnknownKey,
+					Message: "key is not described by the schema",
+				})
+			}
+			return &ValidationError{
+				Fields:  fields,
+				summary: "keys not described by schema: " + strings.Join(unknown, ", "),
+			}
 		}
-		return fmt.Errorf("configuration validation failed: %s", strings.Join(errors, "; "))
 	}
 
 	return nil
 }
 
+// schemaFieldError converts a schema failure into a FieldError. Failures reported on the
+// document root, such as a missing required key, are attributed to the key they name.
+func schemaFieldError(desc gojsonschema.ResultError, config map[string]string) FieldError {
+	key := desc.Field()
+	if property, ok := desc.Details()["property"].(string); ok && key == gojsonschema.STRING_CONTEXT_ROOT {
+		key = property
+	}
+
+	return FieldError{
+		Key:     key,
+		Value:   config[key],
+		Rule:    desc.Type(),
+		Message: desc.Description(),
+		text:    desc.String(),
+	}
+}
+
+// unknownKeys returns the sorted configuration keys that match neither the schema's
+// properties nor its patternProperties.
+func unknownKeys(schemaData []byte, config map[string]string) ([]string, error) {
+	var document struct {
+		Properties        map[string]json.RawMessage `json:"properties"`
+		PatternProperties map[string]json.RawMessage `json:"patternProperties"`
+	}
+	if err := json.Unmarshal(schemaData, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse schema properties: %w", err)
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(document.PatternProperties))
+	for pattern := range document.PatternProperties {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema pattern property %s: %w", pattern, err)
+		}
+		patterns = append(patterns, compiled)
+	}
+
+	var unknown []string
+	for key := range config {
+		if _, described := document.Properties[key]; described {
+			continue
+		}
+		if matchesAny(patterns, key) {
+			continue
+		}
+		unknown = append(unknown, key)
+	}
+	sort.Strings(unknown)
+
+	return unknown, nil
+}
+
+// matchesAny reports whether the key matches any of the patterns.
+func matchesAny(patterns []*regexp.Regexp, key string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Limits defines the size limits enforced by CustomValidator.
+// Zero fields use the package defaults (MaxConfigKeys, MaxKeyLength, MaxValueLength).
+type Limits struct {
+	// MaxKeys is the maximum number of configuration keys.
+	MaxKeys int
+
+	// MaxKeyLength is the maximum length of a configuration key.
+	MaxKeyLength int
+
+	// MaxValueLength is the maximum length of a configuration value.
+	MaxValueLength int
+}
+
+// withDefaults returns the limits with zero fields replaced by the package defaults.
+func (l Limits) withDefaults() Limits {
+	if l.MaxKeys <= 0 {
+		l.MaxKeys = MaxConfigKeys
+	}
+	if l.MaxKeyLength <= 0 {
+		l.MaxKeyLength = MaxKeyLength
+	}
+	if l.MaxValueLength <= 0 {
+		l.MaxValueLength = MaxValueLength
+	}
+	return l
+}
+
 // CustomValidator implements custom validation rules.
 type CustomValidator struct {
-	rules []ValidationRule
+	rules   []ValidationRule
+	limits  Limits
+	lenient bool
 }
 
 // ValidationRule defines a custom validation rule.
@@ -126,44 +259,114 @@ type ValidationRule interface {
 	Validate(key, value string) error
 }
 
-// NewCustomValidator creates a new custom validator.
+// NewCustomValidator creates a new custom validator that checks key and value sizes and
+// applies the rules to every key. Rules passed here used to be accepted but never applied;
+// they are now enforced, so callers passing rules see their violations reported.
 func NewCustomValidator(rules ...ValidationRule) *CustomValidator {
+	return NewCustomValidatorWithLimits(Limits{}, rules...)
+}
+
+// NewCustomValidatorWithLimits creates a custom validator enforcing the given size limits
+// instead of the package defaults.
+func NewCustomValidatorWithLimits(limits Limits, rules ...ValidationRule) *CustomValidator {
 	return &CustomValidator{
-		rules: rules,
+		rules:  rules,
+		limits: limits.withDefaults(),
 	}
 }
 
+// NewCustomValidatorLenient creates a custom validator that returns key, value and rule
+// violations as warnings from ValidateWithWarnings instead of failing. The key count limit
+// is still enforced.
+func NewCustomValidatorLenient(rules ...ValidationRule) *CustomValidator {
+	customValidator := NewCustomValidator(rules...)
+	customValidator.lenient = true
+	return customValidator
+}
+
+// SetLimits replaces the size limits enforced by the validator.
+func (v *CustomValidator) SetLimits(limits Limits) {
+	v.limits = limits.withDefaults()
+}
+
 // Validate validates configuration using custom rules.
-func (v *CustomValidator) Validate(_ context.Context, config map[string]string) error {
-	// Check maximum number of keys
-	if len(config) > MaxConfigKeys {
-		return fmt.Errorf("too many configuration keys: %d > %d", len(config), MaxConfigKeys)
+// All violations are reported as one error; in lenient mode they are ignored.
+func (v *CustomValidator) Validate(ctx context.Context, config map[string]string) error {
+	_, err := v.ValidateWithWarnings(ctx, config)
+	return err
+}
+
+// ValidateWithWarnings validates configuration using custom rules and reports all violations
+// together: as one error, or as the returned warnings in lenient mode.
+func (v *CustomValidator) ValidateWithWarnings(_ context.Context, config map[string]string) ([]string, error) {
+	var violations []FieldError
+
+	// Check maximum number of keys (enforced in both modes)
+	if len(config) > v.limits.MaxKeys {
+		return nil, fmt.Errorf("too many configuration keys: %d exceeds the limit of %d", len(config), v.limits.MaxKeys)
 	}
 
-	// Validate each key-value pair
-	for key, value := range config {
+	// Validate each key-value pair in sorted order for stable reporting
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := config[key]
+
 		// Validate key
-		if err := validateKey(key); err != nil {
-			return fmt.Errorf("invalid key %s: %w", key, err)
+		if keyErr := validateKey(key, v.limits.MaxKeyLength); keyErr != nil {
+			violations = append(violations, FieldError{
+				Key: key, Value: value, Rule: RuleKey, Message: keyErr.Error(),
+				text: fmt.Sprintf("invalid key %s: %v", key, keyErr),
+			})
+			continue
 		}
 
 		// Validate value
-		if err := validateValue(key, value); err != nil {
-			return fmt.Errorf("invalid value for key %s: %w", key, err)
+		if valueErr := validateValue(value, v.limits.MaxValueLength); valueErr != nil {
+			violations = append(violations, FieldError{
+				Key: key, Value: value, Rule: RuleValue, Message: valueErr.Error(),
+				text: fmt.Sprintf("invalid value for key %s: %v", key, valueErr),
+			})
+		}
+
+		// Apply custom rules
+		for _, rule := range v.rules {
+			if ruleErr := rule.Validate(key, value); ruleErr != nil {
+				violations = append(violations, FieldError{
+					Key: key, Value: value, Rule: rule.Name(), Message: ruleErr.Error(),
+					text: fmt.Sprintf("rule %s failed: %v", rule.Name(), ruleErr),
+				})
+			}
 		}
 	}
 
-	return nil
+	if len(violations) == 0 {
+		return nil, nil
+	}
+
+	if v.lenient {
+		warnings := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			warnings = append(warnings, violation.String())
+		}
+		return warnings, nil
+	}
+
+	return nil, &ValidationError{Fields: violations}
 }
 
 // validateKey validates a configuration key.
-func validateKey(key string) error {
+func validateKey(key string, maxLength int) error {
 	if strings.TrimSpace(key) == "" {
 		return fmt.Errorf("key cannot be empty")
 	}
 
-	if len(key) > MaxKeyLength {
-		return fmt.Errorf("key too long: %d > %d", len(key), MaxKeyLength)
+	if len(key) > maxLength {
+		return fmt.Errorf("key too long: %d > %d", len(key), maxLength)
 	}
 
 	// Check for invalid characters
@@ -175,9 +378,9 @@ func validateKey(key string) error {
 }
 
 // validateValue validates a configuration value.
-func validateValue(_, value string) error {
-	if len(value) > MaxValueLength {
-		return fmt.Errorf("value too long: %d > %d", len(value), MaxValueLength)
+func validateValue(value string, maxLength int) error {
+	if len(value) > maxLength {
+		return fmt.Errorf("value too long: %d > %d", len(value), maxLength)
 	}
 
 	// Additional validation rules can be added here
@@ -205,11 +408,31 @@ func NewCompositeValidator(validators ...Validator) *CompositeValidator {
 
 // Validate validates configuration using all configured validators.
 func (v *CompositeValidator) Validate(ctx context.Context, config map[string]string) error {
+	_, err := v.ValidateWithWarnings(ctx, config)
+	return err
+}
+
+// ValidateWithWarnings validates configuration using all configured validators and returns
+// the warnings of those that report them.
+func (v *CompositeValidator) ValidateWithWarnings(ctx context.Context, config map[string]string) ([]string, error) {
+	var warnings []string
 	for _, validator := range v.validators {
-		if err := validator.Validate(ctx, config); err != nil {
-			return err
+		reporter, ok := validator.(interface {
+			ValidateWithWarnings(ctx context.Context, config map[string]string) ([]string, error)
+		})
+		if !ok {
+			if err := validator.Validate(ctx, config); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		validatorWarnings, err := reporter.ValidateWithWarnings(ctx, config)
+		if err != nil {
+			return nil, err
 		}
+		warnings = append(warnings, validatorWarnings...)
 	}
 
-	return nil
+	return warnings, nil
 }
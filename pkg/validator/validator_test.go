@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestCustomValidatorLenientWarnings(t *testing.T) {
+	rule, err := NewNamingRule(NamingUpperSnake)
+	if err != nil {
+		t.Fatalf("NewNamingRule() error = %v", err)
+	}
+	v := NewCustomValidatorLenient(rule)
+
+	warnings, err := v.ValidateWithWarnings(context.Background(), map[string]string{"lower": "1", "GOOD": "2"})
+	if err != nil {
+		t.Fatalf("ValidateWithWarnings() error = %v, want warnings only", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "lower") {
+		t.Errorf("ValidateWithWarnings() warnings = %q, want one for lower", warnings)
+	}
+
+	// Warnings belong to the call that found them
+	warnings, err = v.ValidateWithWarnings(context.Background(), map[string]string{"GOOD": "2"})
+	if err != nil || len(warnings) != 0 {
+		t.Errorf("ValidateWithWarnings() = %q, %v, want no warnings", warnings, err)
+	}
+	if err := v.Validate(context.Background(), map[string]string{"lower": "1"}); err != nil {
+		t.Errorf("Validate() error = %v, want nil in lenient mode", err)
+	}
+}
+
+func TestCompositeValidatorWarnings(t *testing.T) {
+	rule, err := NewNamingRule(NamingUpperSnake)
+	if err != nil {
+		t.Fatalf("NewNamingRule() error = %v", err)
+	}
+	composite := NewCompositeValidator(NewCustomValidatorLenient(rule), NewCustomValidator())
+
+	warnings, err := composite.ValidateWithWarnings(context.Background(), map[string]string{"lower": "1"})
+	if err != nil || len(warnings) != 1 {
+		t.Errorf("ValidateWithWarnings() = %q, %v, want one warning", warnings, err)
+	}
+
+	strict := NewCompositeValidator(NewCustomValidator(rule))
+	if _, err := strict.ValidateWithWarnings(context.Background(), map[string]string{"lower": "1"}); err == nil {
+		t.Error("ValidateWithWarnings() = nil, want the rule violation as an error")
+	}
+}